@@ -23,12 +23,25 @@ export default [
   { text: 'kyma alpha provision', link: './gen-docs/kyma_alpha_provision' },
   { text: 'kyma alpha reference-instance', link: './gen-docs/kyma_alpha_reference-instance' },
   { text: 'kyma app', link: './gen-docs/kyma_app' },
+  { text: 'kyma app delete', link: './gen-docs/kyma_app_delete' },
+  { text: 'kyma app dev', link: './gen-docs/kyma_app_dev' },
+  { text: 'kyma app list', link: './gen-docs/kyma_app_list' },
+  { text: 'kyma app logs', link: './gen-docs/kyma_app_logs' },
+  { text: 'kyma app port-forward', link: './gen-docs/kyma_app_port-forward' },
   { text: 'kyma app push', link: './gen-docs/kyma_app_push' },
+  { text: 'kyma app restart', link: './gen-docs/kyma_app_restart' },
+  { text: 'kyma app status', link: './gen-docs/kyma_app_status' },
   { text: 'kyma completion', link: './gen-docs/kyma_completion' },
   { text: 'kyma completion bash', link: './gen-docs/kyma_completion_bash' },
   { text: 'kyma completion fish', link: './gen-docs/kyma_completion_fish' },
   { text: 'kyma completion powershell', link: './gen-docs/kyma_completion_powershell' },
   { text: 'kyma completion zsh', link: './gen-docs/kyma_completion_zsh' },
+  { text: 'kyma extension', link: './gen-docs/kyma_extension' },
+  { text: 'kyma extension inspect', link: './gen-docs/kyma_extension_inspect' },
+  { text: 'kyma extension list', link: './gen-docs/kyma_extension_list' },
+  { text: 'kyma extension render', link: './gen-docs/kyma_extension_render' },
+  { text: 'kyma extension validate', link: './gen-docs/kyma_extension_validate' },
+  { text: 'kyma extension versions', link: './gen-docs/kyma_extension_versions' },
   { text: 'kyma help', link: './gen-docs/kyma_help' },
   { text: 'kyma module', link: './gen-docs/kyma_module' },
   { text: 'kyma module add', link: './gen-docs/kyma_module_add' },
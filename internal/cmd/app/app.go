@@ -14,6 +14,13 @@ func NewAppCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	}
 
 	cmd.AddCommand(NewAppPushCMD(kymaConfig))
+	cmd.AddCommand(NewAppDevCMD(kymaConfig))
+	cmd.AddCommand(NewAppListCMD(kymaConfig))
+	cmd.AddCommand(NewAppStatusCMD(kymaConfig))
+	cmd.AddCommand(NewAppLogsCMD(kymaConfig))
+	cmd.AddCommand(NewAppPortForwardCMD(kymaConfig))
+	cmd.AddCommand(NewAppRestartCMD(kymaConfig))
+	cmd.AddCommand(NewAppDeleteCMD(kymaConfig))
 
 	return cmd
 }
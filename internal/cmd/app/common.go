@@ -0,0 +1,168 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/istio"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// getApp returns the Deployment of the app pushed by the CLI
+func getApp(ctx context.Context, client kubernetes.Interface, name, namespace string) (*appsv1.Deployment, clierror.Error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || (err == nil && !resources.IsCreatedByCLI(deployment.Labels)) {
+		return nil, clierror.New(
+			fmt.Sprintf("app %s/%s not found", namespace, name),
+			"make sure the app was pushed using the 'kyma app push' command",
+			"use the 'kyma app list' command to list pushed apps",
+		)
+	}
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get app %s/%s", namespace, name)))
+	}
+
+	return deployment, nil
+}
+
+// listAppPods returns pods selected by the app Deployment
+func listAppPods(ctx context.Context, client kubernetes.Interface, deployment *appsv1.Deployment) ([]corev1.Pod, clierror.Error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to build selector of the app pods"))
+	}
+
+	pods, err := client.CoreV1().Pods(deployment.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to list app pods"))
+	}
+
+	return pods.Items, nil
+}
+
+// getReadyAppPod returns the name of the newest app pod with all containers ready
+func getReadyAppPod(ctx context.Context, client kubernetes.Interface, name, namespace string) (string, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(map[string]string{resources.AppSelectorLabel: name}).String(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list pods of the %s/%s app: %w", namespace, name, err)
+	}
+
+	var readyPod *corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !isPodReady(pod) {
+			continue
+		}
+		if readyPod == nil || readyPod.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			readyPod = pod
+		}
+	}
+
+	if readyPod == nil {
+		return "", fmt.Errorf("no ready pods found for the %s/%s app", namespace, name)
+	}
+
+	return readyPod.Name, nil
+}
+
+// isPodReady returns true if the running pod is not terminating and all its containers are ready
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning || len(pod.Status.ContainerStatuses) == 0 {
+		return false
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if !status.Ready {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rolloutState describes the rollout of the Deployment
+type rolloutState struct {
+	message  string
+	complete bool
+	failed   bool
+}
+
+func (s rolloutState) String() string {
+	return s.message
+}
+
+// getRolloutState describes the rollout state of the Deployment the same way as the 'kubectl rollout status' command
+func getRolloutState(deployment *appsv1.Deployment) rolloutState {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return rolloutState{message: "in progress (waiting for the deployment spec update to be observed)"}
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			return rolloutState{message: "failed (progress deadline exceeded)", failed: true}
+		}
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	status := deployment.Status
+	if status.UpdatedReplicas < replicas {
+		return rolloutState{message: fmt.Sprintf("in progress (%d of %d new replicas have been updated)", status.UpdatedReplicas, replicas)}
+	}
+	if status.Replicas > status.UpdatedReplicas {
+		return rolloutState{message: fmt.Sprintf("in progress (%d old replicas are pending termination)", status.Replicas-status.UpdatedReplicas)}
+	}
+	if status.AvailableReplicas < status.UpdatedReplicas {
+		return rolloutState{message: fmt.Sprintf("in progress (%d of %d updated replicas are available)", status.AvailableReplicas, status.UpdatedReplicas)}
+	}
+
+	return rolloutState{message: "complete", complete: true}
+}
+
+// getAPIRuleURL returns the URL of the APIRule host joined with the cluster domain
+// the host of the APIRule's VirtualService is used if the cluster domain can't be resolved
+func getAPIRuleURL(ctx context.Context, client kube.Client, name, namespace, host string) (string, clierror.Error) {
+	if strings.Contains(host, ".") {
+		// fully qualified domain name doesn't need the cluster domain
+		return resources.APIRuleURL(host, ""), nil
+	}
+
+	domain, domainErr := resources.GetClusterDomain(ctx, client)
+	if domainErr == nil {
+		return resources.APIRuleURL(host, domain), nil
+	}
+
+	authRes, err := resources.CreateSelfSubjectAccessReview(ctx, client, "watch", "virtualservices", namespace, "networking.istio.io")
+	if err != nil {
+		return "", clierror.Wrap(err, clierror.New("failed to check permissions to get VirtualServices"))
+	}
+	if !authRes.Status.Allowed {
+		return "", clierror.Wrap(domainErr, clierror.New("failed to resolve the cluster domain",
+			fmt.Sprintf("make sure you can get the %s Gateway in the %s namespace or the shoot-info ConfigMap in the kube-system namespace", istio.DefaultGatewayName, istio.DefaultGatewayNamespace),
+			"use the fully qualified domain name in the --expose-host flag",
+		))
+	}
+
+	vsHost, clierr := client.Istio().GetHostFromVirtualServiceByApiruleName(ctx, name, namespace)
+	if clierr != nil {
+		return "", clierror.WrapE(clierr, clierror.New("failed to get host address of APIRule's VirtualService"))
+	}
+
+	return resources.APIRuleURL(vsHost, ""), nil
+}
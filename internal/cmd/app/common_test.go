@@ -0,0 +1,255 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+func Test_getApp(t *testing.T) {
+	t.Run("get app created by the CLI", func(t *testing.T) {
+		client := k8sfake.NewClientset(fixAppDeployment("my-app", "default"))
+
+		deployment, clierr := getApp(context.Background(), client, "my-app", "default")
+		require.Nil(t, clierr)
+		require.Equal(t, "my-app", deployment.Name)
+	})
+
+	t.Run("deployment not created by the CLI", func(t *testing.T) {
+		deployment := fixAppDeployment("my-app", "default")
+		deployment.Labels = nil
+		client := k8sfake.NewClientset(deployment)
+
+		_, clierr := getApp(context.Background(), client, "my-app", "default")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app default/my-app not found")
+	})
+
+	t.Run("app not found", func(t *testing.T) {
+		_, clierr := getApp(context.Background(), k8sfake.NewClientset(), "my-app", "default")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app default/my-app not found")
+	})
+}
+
+func Test_getReadyAppPod(t *testing.T) {
+	t.Run("get the newest ready pod", func(t *testing.T) {
+		oldPod := fixAppPod("my-app-1", "my-app", "default")
+		newPod := fixAppPod("my-app-2", "my-app", "default")
+		newPod.CreationTimestamp = metav1.NewTime(time.Now())
+		terminatingPod := fixAppPod("my-app-3", "my-app", "default")
+		terminatingPod.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Minute))
+		terminatingPod.DeletionTimestamp = ptr.To(metav1.Now())
+		notReadyPod := fixAppPod("my-app-4", "my-app", "default")
+		notReadyPod.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Minute))
+		notReadyPod.Status.ContainerStatuses[0].Ready = false
+		client := k8sfake.NewClientset(oldPod, newPod, terminatingPod, notReadyPod, fixAppPod("other-app-1", "other-app", "default"))
+
+		podName, err := getReadyAppPod(context.Background(), client, "my-app", "default")
+		require.NoError(t, err)
+		require.Equal(t, "my-app-2", podName)
+	})
+
+	t.Run("no ready pods", func(t *testing.T) {
+		pod := fixAppPod("my-app-1", "my-app", "default")
+		pod.Status.Phase = corev1.PodPending
+		client := k8sfake.NewClientset(pod)
+
+		_, err := getReadyAppPod(context.Background(), client, "my-app", "default")
+		require.EqualError(t, err, "no ready pods found for the default/my-app app")
+	})
+}
+
+func Test_getRolloutState(t *testing.T) {
+	tests := []struct {
+		name   string
+		status appsv1.DeploymentStatus
+		gen    int64
+		want   string
+	}{
+		{
+			name: "complete",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2,
+			},
+			want: "complete",
+		},
+		{
+			name: "spec update not observed",
+			gen:  2,
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+			},
+			want: "in progress (waiting for the deployment spec update to be observed)",
+		},
+		{
+			name: "new replicas not updated",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 1,
+			},
+			want: "in progress (1 of 2 new replicas have been updated)",
+		},
+		{
+			name: "old replicas pending termination",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 2,
+			},
+			want: "in progress (1 old replicas are pending termination)",
+		},
+		{
+			name: "updated replicas not available",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1,
+			},
+			want: "in progress (1 of 2 updated replicas are available)",
+		},
+		{
+			name: "progress deadline exceeded",
+			status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+				},
+			},
+			want: "failed (progress deadline exceeded)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := fixAppDeployment("my-app", "default")
+			deployment.Generation = max(tt.gen, 1)
+			deployment.Status = tt.status
+
+			state := getRolloutState(deployment)
+			require.Equal(t, tt.want, state.String())
+			require.Equal(t, tt.want == "complete", state.complete)
+			require.Equal(t, strings.HasPrefix(tt.want, "failed"), state.failed)
+		})
+	}
+}
+
+func Test_getAPIRuleURL(t *testing.T) {
+	t.Run("fully qualified domain name", func(t *testing.T) {
+		url, clierr := getAPIRuleURL(context.Background(), fixKubeClient(), "my-app", "default", "api.example.com")
+		require.Nil(t, clierr)
+		require.Equal(t, "https://api.example.com", url)
+	})
+
+	t.Run("subdomain of the cluster domain", func(t *testing.T) {
+		kubeClient := fixKubeClient(fixShootInfo("example.com"))
+
+		url, clierr := getAPIRuleURL(context.Background(), kubeClient, "my-app", "default", "my-app")
+		require.Nil(t, clierr)
+		require.Equal(t, "https://my-app.example.com", url)
+	})
+
+	t.Run("cluster domain can't be resolved", func(t *testing.T) {
+		kubeClient := fixKubeClient()
+		// user is not allowed to watch VirtualServices
+		kubeClient.TestKubernetesInterface.(*k8sfake.Clientset).PrependReactor("create", "selfsubjectaccessreviews",
+			func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &authv1.SelfSubjectAccessReview{}, nil
+			})
+
+		_, clierr := getAPIRuleURL(context.Background(), kubeClient, "my-app", "default", "my-app")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to resolve the cluster domain")
+		require.Contains(t, clierr.String(), "use the fully qualified domain name in the --expose-host flag")
+	})
+}
+
+type fakeKubeClientConfig struct {
+	kubeClient kube.Client
+}
+
+func (f *fakeKubeClientConfig) GetKubeClient() (kube.Client, error) {
+	return f.kubeClient, nil
+}
+
+func (f *fakeKubeClientConfig) GetKubeClientWithClierr() (kube.Client, clierror.Error) {
+	return f.kubeClient, nil
+}
+
+func fixKymaConfig(kubeClient kube.Client) *cmdcommon.KymaConfig {
+	return &cmdcommon.KymaConfig{
+		Ctx:              context.Background(),
+		KubeClientConfig: &fakeKubeClientConfig{kubeClient: kubeClient},
+	}
+}
+
+func fixKubeClient(objs ...runtime.Object) *kubefake.KubeClient {
+	return &kubefake.KubeClient{
+		TestKubernetesInterface:      k8sfake.NewClientset(objs...),
+		TestDynamicInterface:         dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		TestRootlessDynamicInterface: &kubefake.RootlessDynamicClient{},
+	}
+}
+
+func fixAppDeployment(name, namespace string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			Generation:        1,
+			Labels:            resources.AppLabels(name),
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(2)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{resources.AppSelectorLabel: name},
+			},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: name, Image: "registry/" + name + ":1.0.0"},
+					},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1,
+			Replicas:           2,
+			ReadyReplicas:      2,
+			UpdatedReplicas:    2,
+			AvailableReplicas:  2,
+		},
+	}
+}
+
+func fixAppPod(name, appName, namespace string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			Labels:            map[string]string{resources.AppSelectorLabel: appName},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: appName}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: appName, Ready: true, RestartCount: 1},
+			},
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type appDeleteConfig struct {
+	*cmdcommon.KymaConfig
+
+	name        string
+	namespace   string
+	autoApprove bool
+}
+
+func NewAppDeleteCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := appDeleteConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <name> [flags]",
+		Short: "Deletes the app",
+		Long:  "Use this command to delete the Deployment, Service, APIRule, and HorizontalPodAutoscaler created for the app by the 'kyma app push' command.",
+		Example: `  # Delete the my-app app
+  kyma app delete my-app --namespace my-namespace
+
+  # Delete the my-app app without the confirmation prompt
+  kyma app delete my-app --auto-approve`,
+		Aliases: []string{"del"},
+		Args:    cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.name = args[0]
+			clierror.Check(runAppDelete(&cfg, out.Default))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Namespace where the app is deployed")
+	cmd.Flags().BoolVar(&cfg.autoApprove, "auto-approve", false, "Automatically approves the app removal")
+
+	return cmd
+}
+
+func runAppDelete(cfg *appDeleteConfig, printer *out.Printer) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	_, clierr = getApp(cfg.Ctx, client.Static(), cfg.name, cfg.namespace)
+	if clierr != nil {
+		return clierr
+	}
+
+	if !cfg.autoApprove {
+		confirmation, err := prompt.NewBool(fmt.Sprintf("Are you sure you want to delete app %s/%s with its Service and APIRule?", cfg.namespace, cfg.name), false).Prompt()
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to prompt for user input", "if error repeats, consider running the command with --auto-approve flag"))
+		}
+
+		if !confirmation {
+			return nil
+		}
+	}
+
+	// delete resources in the reverse order to the push command to stop exposing the app first
+	clierr = deleteAppAPIRule(cfg, client, printer)
+	if clierr != nil {
+		return clierr
+	}
+
+	clierr = deleteAppService(cfg, client, printer)
+	if clierr != nil {
+		return clierr
+	}
+
+	clierr = deleteAppHPA(cfg, client, printer)
+	if clierr != nil {
+		return clierr
+	}
+
+	err := client.Static().AppsV1().Deployments(cfg.namespace).Delete(cfg.Ctx, cfg.name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return clierror.Wrap(err, clierror.New("failed to delete Deployment"))
+	}
+	printer.Msgfln("Deployment %s/%s deleted", cfg.namespace, cfg.name)
+
+	return nil
+}
+
+func deleteAppAPIRule(cfg *appDeleteConfig, client kube.Client, printer *out.Printer) clierror.Error {
+	apirule, err := client.RootlessDynamic().Get(cfg.Ctx, resources.AppAPIRule(cfg.name, cfg.namespace))
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) || (err == nil && !resources.IsCreatedByCLI(apirule.GetLabels())) {
+		// the app is not exposed
+		return nil
+	}
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get APIRule"))
+	}
+
+	err = client.RootlessDynamic().Remove(cfg.Ctx, apirule, false)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return clierror.Wrap(err, clierror.New("failed to delete APIRule"))
+	}
+
+	printer.Msgfln("APIRule %s/%s deleted", cfg.namespace, cfg.name)
+	return nil
+}
+
+func deleteAppService(cfg *appDeleteConfig, client kube.Client, printer *out.Printer) clierror.Error {
+	service, err := client.Static().CoreV1().Services(cfg.namespace).Get(cfg.Ctx, cfg.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || (err == nil && !resources.IsCreatedByCLI(service.Labels)) {
+		// the app was pushed without the container port
+		return nil
+	}
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get Service"))
+	}
+
+	err = client.Static().CoreV1().Services(cfg.namespace).Delete(cfg.Ctx, cfg.name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return clierror.Wrap(err, clierror.New("failed to delete Service"))
+	}
+
+	printer.Msgfln("Service %s/%s deleted", cfg.namespace, cfg.name)
+	return nil
+}
+
+func deleteAppHPA(cfg *appDeleteConfig, client kube.Client, printer *out.Printer) clierror.Error {
+	hpa, err := client.Static().AutoscalingV2().HorizontalPodAutoscalers(cfg.namespace).Get(cfg.Ctx, cfg.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) || (err == nil && !resources.IsCreatedByCLI(hpa.Labels)) {
+		// the app was pushed without autoscaling
+		return nil
+	}
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get HorizontalPodAutoscaler"))
+	}
+
+	err = client.Static().AutoscalingV2().HorizontalPodAutoscalers(cfg.namespace).Delete(cfg.Ctx, cfg.name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return clierror.Wrap(err, clierror.New("failed to delete HorizontalPodAutoscaler"))
+	}
+
+	printer.Msgfln("HorizontalPodAutoscaler %s/%s deleted", cfg.namespace, cfg.name)
+	return nil
+}
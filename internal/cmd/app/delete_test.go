@@ -0,0 +1,99 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func Test_runAppDelete(t *testing.T) {
+	t.Run("delete Deployment, Service, HorizontalPodAutoscaler and APIRule", func(t *testing.T) {
+		kubeClient := fixKubeClient(
+			fixAppDeployment("my-app", "default"),
+			fixAppService("my-app", "default", resources.AppLabels("my-app")),
+			&autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "default", Labels: resources.AppLabels("my-app")},
+			},
+		)
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnGetObj: fixAppAPIRule("my-app", "default"),
+		}
+		kubeClient.TestRootlessDynamicInterface = rootlessDynamic
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppDelete(&appDeleteConfig{
+			KymaConfig:  fixKymaConfig(kubeClient),
+			name:        "my-app",
+			namespace:   "default",
+			autoApprove: true,
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Equal(t, "APIRule default/my-app deleted\n"+
+			"Service default/my-app deleted\n"+
+			"HorizontalPodAutoscaler default/my-app deleted\n"+
+			"Deployment default/my-app deleted\n", buf.String())
+		require.Equal(t, []unstructured.Unstructured{fixAppAPIRule("my-app", "default")}, rootlessDynamic.RemovedObjs)
+
+		_, err := kubeClient.Static().AppsV1().Deployments("default").Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.True(t, apierrors.IsNotFound(err))
+		_, err = kubeClient.Static().CoreV1().Services("default").Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("skip resources not created by the CLI", func(t *testing.T) {
+		kubeClient := fixKubeClient(
+			fixAppDeployment("my-app", "default"),
+			fixAppService("my-app", "default", nil),
+		)
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnGetErr: apierrors.NewNotFound(schema.GroupResource{Resource: "apirules"}, "my-app"),
+		}
+		kubeClient.TestRootlessDynamicInterface = rootlessDynamic
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppDelete(&appDeleteConfig{
+			KymaConfig:  fixKymaConfig(kubeClient),
+			name:        "my-app",
+			namespace:   "default",
+			autoApprove: true,
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Equal(t, "Deployment default/my-app deleted\n", buf.String())
+		require.Empty(t, rootlessDynamic.RemovedObjs)
+
+		_, err := kubeClient.Static().CoreV1().Services("default").Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("app not found", func(t *testing.T) {
+		clierr := runAppDelete(&appDeleteConfig{
+			KymaConfig:  fixKymaConfig(fixKubeClient()),
+			name:        "my-app",
+			namespace:   "default",
+			autoApprove: true,
+		}, out.NewToWriter(bytes.NewBuffer([]byte{})))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app default/my-app not found")
+	})
+}
+
+func fixAppService(name, namespace string, labels map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+	}
+}
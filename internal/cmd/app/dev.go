@@ -0,0 +1,252 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// flags of the push command that don't apply to the app built and rolled out by the dev mode
+var appDevUnsupportedFlags = []string{"image", "image-pull-secret", "build-tag", "watch", "quiet"}
+
+// maximum number of changed files listed before the app is rebuilt
+const devListedChanges = 5
+
+type appDevConfig struct {
+	appPushConfig
+
+	debounce time.Duration
+}
+
+func NewAppDevCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := appDevConfig{
+		appPushConfig: newAppPushConfig(kymaConfig),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "dev [flags]",
+		Short: "Runs the app in the development mode",
+		Long: `Use this command to build the app from its source code, push it to the Kubernetes cluster, and push it again on every change of the source code.
+Files ignored by the .gitignore and .dockerignore files of the source directory don't trigger the rebuild.
+Only changed image layers are rebuilt and imported into the in-cluster registry.
+The command prints logs of the app and forwards the app ports to localhost until it's interrupted.`,
+		Example: `  # Run the app built using Cloud Native Buildpacks and forward its port to localhost:8080:
+  kyma app dev --name my-app --code-path . --container-port 8080
+
+  # Run the app built from the Dockerfile and wait 2 seconds for more changes before rebuilding it:
+  kyma app dev --name my-app --dockerfile ./Dockerfile --container-port 8080 --debounce 2s`,
+
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			clierror.Check(cfg.complete())
+			clierror.Check(flags.Validate(cmd.Flags(), appDevFlagRules...))
+			clierror.Check(cfg.validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runAppDev(&cfg))
+		},
+	}
+
+	cfg.addFlags(cmd.Flags())
+	cmd.Flags().DurationVar(&cfg.debounce, "debounce", 500*time.Millisecond, "Time to wait for more changes in the source code before the app is rebuilt")
+
+	return cmd
+}
+
+var appDevFlagRules = append([]flags.Rule{
+	flags.MarkRequired("name"),
+	flags.MarkExactlyOneRequired("dockerfile", "code-path"),
+}, appDeploymentFlagRules...)
+
+// addFlags adds flags of the push command that apply to the dev mode
+func (adc *appDevConfig) addFlags(flagSet *pflag.FlagSet) {
+	pushFlagSet := pflag.NewFlagSet("push", pflag.ContinueOnError)
+	adc.appPushConfig.addFlags(pushFlagSet)
+
+	pushFlagSet.VisitAll(func(flag *pflag.Flag) {
+		if slices.Contains(appDevUnsupportedFlags, flag.Name) {
+			return
+		}
+		if flag.Name == "timeout" {
+			flag.Usage = "Maximum time to wait for every app rollout"
+		}
+		flagSet.AddFlag(flag)
+	})
+}
+
+// sourceDir returns the directory with the app source code
+func (adc *appDevConfig) sourceDir() string {
+	if adc.packAppPath != "" {
+		return adc.packAppPath
+	}
+
+	return adc.dockerfileSrcContext
+}
+
+func runAppDev(cfg *appDevConfig) clierror.Error {
+	ctx, stop := signal.NotifyContext(cfg.Ctx, os.Interrupt)
+	defer stop()
+
+	kymaConfig := *cfg.KymaConfig
+	kymaConfig.Ctx = ctx
+	cfg.KymaConfig = &kymaConfig
+	// the dev mode always waits for the rollout before the app logs are printed
+	cfg.watch = true
+
+	watcher, err := newSourceWatcher(cfg.sourceDir(), cfg.debounce)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to watch the %s directory", cfg.sourceDir())))
+	}
+
+	clierr := runAppPush(&cfg.appPushConfig)
+	if clierr != nil {
+		return clierr
+	}
+
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	clierr = forwardAppPorts(ctx, client, cfg.name, cfg.namespace, cfg.appPorts())
+	if clierr != nil {
+		return clierr
+	}
+
+	changes := make(chan []string)
+	go watcher.run(ctx, changes, func(err error) {
+		out.Errfln("failed to watch source code changes: %s", err.Error())
+	})
+
+	out.Msgfln("\nWatching %s for changes, press Ctrl+C to stop\n", cfg.sourceDir())
+	stopLogs := tailAppLogs(ctx, client.Static(), out.Default, cfg.name, cfg.namespace)
+
+	for {
+		select {
+		case <-ctx.Done():
+			stopLogs()
+			out.Msgln("\nDevelopment mode stopped")
+			return nil
+		case changed := <-changes:
+			stopLogs()
+			out.Msgfln("\nDetected changes in %s, rebuilding the app\n", describeChanges(changed))
+
+			clierr = redeployApp(cfg, client)
+			if clierr != nil {
+				if ctx.Err() != nil {
+					continue
+				}
+				// keep watching to let the user fix the app source code
+				out.Errln(clierr.String())
+				out.Msgln("Waiting for the next change")
+			}
+
+			stopLogs = tailAppLogs(ctx, client.Static(), out.Default, cfg.name, cfg.namespace)
+		}
+	}
+}
+
+// redeployApp rebuilds the app image, applies the Deployment with the new image, and waits for its rollout
+func redeployApp(cfg *appDevConfig, client kube.Client) clierror.Error {
+	imagePullSecret, clierr := applyAppDeployment(&cfg.appPushConfig, client)
+	if clierr != nil {
+		return clierr
+	}
+
+	return waitForAppRollout(&cfg.appPushConfig, client, imagePullSecret)
+}
+
+// forwardAppPorts forwards connections from the same local ports to the ready app pod until the context is done
+func forwardAppPorts(ctx context.Context, client kube.Client, name, namespace string, ports []types.PortSpec) clierror.Error {
+	for _, port := range ports {
+		listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port.Port))
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to listen on the local port %d", port.Port),
+				"make sure the port is not used by another process"))
+		}
+
+		go func() {
+			err := forwardAppPort(ctx, client, out.Default, name, namespace, listener, port.Port)
+			if err != nil {
+				out.Errln(err.Error())
+			}
+		}()
+
+		out.Msgfln("\nForwarding localhost:%d to the %s port of the app", port.Port, port.Name)
+	}
+
+	return nil
+}
+
+// tailAppLogs prints logs of the app pods until the returned function is called
+func tailAppLogs(ctx context.Context, client kubernetes.Interface, printer *out.Printer, name, namespace string) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		clierr := followAppLogs(ctx, client, printer, name, namespace)
+		if clierr != nil && ctx.Err() == nil {
+			printer.Errln(clierr.String())
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// followAppLogs streams logs of the app pods that are not terminating
+func followAppLogs(ctx context.Context, client kubernetes.Interface, printer *out.Printer, name, namespace string) clierror.Error {
+	deployment, clierr := getApp(ctx, client, name, namespace)
+	if clierr != nil {
+		return clierr
+	}
+
+	pods, clierr := listAppPods(ctx, client, deployment)
+	if clierr != nil {
+		return clierr
+	}
+
+	// skip pods replaced by the last rollout
+	pods = slices.DeleteFunc(pods, func(pod corev1.Pod) bool {
+		return pod.DeletionTimestamp != nil
+	})
+
+	streamer := &podLogsStreamer{
+		client:  client,
+		printer: printer,
+		opts: &corev1.PodLogOptions{
+			Container: name,
+			Follow:    true,
+		},
+		prefixed: len(pods) > 1,
+	}
+
+	return streamer.streamAll(ctx, pods)
+}
+
+// describeChanges lists the first changed files and the number of the remaining ones
+func describeChanges(changed []string) string {
+	if len(changed) <= devListedChanges {
+		return strings.Join(changed, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more files", strings.Join(changed[:devListedChanges], ", "), len(changed)-devListedChanges)
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func Test_appDevConfig_flags(t *testing.T) {
+	t.Run("skip flags of the pushed image", func(t *testing.T) {
+		cmd := NewAppDevCMD(fixKymaConfig(fixKubeClient()))
+
+		for _, flag := range appDevUnsupportedFlags {
+			require.Nil(t, cmd.Flags().Lookup(flag), flag)
+		}
+		require.NotNil(t, cmd.Flags().Lookup("code-path"))
+		require.NotNil(t, cmd.Flags().Lookup("timeout"))
+		require.NotNil(t, cmd.Flags().Lookup("debounce"))
+	})
+
+	t.Run("require source code", func(t *testing.T) {
+		cmd := NewAppDevCMD(fixKymaConfig(fixKubeClient()))
+		require.NoError(t, cmd.Flags().Parse([]string{"--name", "my-app"}))
+
+		clierr := flags.Validate(cmd.Flags(), appDevFlagRules...)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "exactly one from group [dockerfile code-path] must be set")
+	})
+
+	t.Run("validate flags of the app resources", func(t *testing.T) {
+		cmd := NewAppDevCMD(fixKymaConfig(fixKubeClient()))
+		require.NoError(t, cmd.Flags().Parse([]string{"--name", "my-app", "--code-path", ".", "--expose"}))
+
+		clierr := flags.Validate(cmd.Flags(), appDevFlagRules...)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "at least one of the flags from the group [container-port port] must be set when [expose] flag is used")
+	})
+}
+
+func Test_followAppLogs(t *testing.T) {
+	terminatingPod := fixAppPod("my-app-1", "my-app", "default")
+	terminatingPod.DeletionTimestamp = ptr.To(metav1.Now())
+	terminatingPod.Finalizers = []string{"test"}
+	client := k8sfake.NewClientset(
+		fixAppDeployment("my-app", "default"),
+		terminatingPod,
+		fixAppPod("my-app-2", "my-app", "default"),
+	)
+
+	buf := bytes.NewBuffer([]byte{})
+	clierr := followAppLogs(context.Background(), client, out.NewToWriter(buf), "my-app", "default")
+	require.Nil(t, clierr)
+	require.Equal(t, "fake logs\n", buf.String())
+}
+
+func Test_describeChanges(t *testing.T) {
+	require.Equal(t, "main.go", describeChanges([]string{"main.go"}))
+	require.Equal(t, "go.mod, main.go", describeChanges([]string{"go.mod", "main.go"}))
+	require.Equal(t, "a.go, b.go, c.go, d.go, e.go and 2 more files",
+		describeChanges([]string{"a.go", "b.go", "c.go", "d.go", "e.go", "f.go", "g.go"}))
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const kustomizationFileName = "kustomization.yaml"
+
+// appPushOutput configures the dry run of the push command that renders app resources instead of applying them
+type appPushOutput struct {
+	dryRun    bool
+	format    types.Format
+	outputDir string
+	kustomize bool
+}
+
+func (apo *appPushOutput) addFlags(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&apo.dryRun, "dry-run", false, "Prints resources of the app instead of applying them (requires a pre-built image)")
+	flagSet.VarP(&apo.format, "output", "o", "Output format of the dry run (Possible values: yaml, json)")
+	flagSet.StringVar(&apo.outputDir, "output-dir", "", "Directory where the dry run writes every resource of the app to a separate file")
+	flagSet.BoolVar(&apo.kustomize, "kustomize", false, "Writes the kustomization.yaml file listing all resources written to the output directory")
+}
+
+var appPushOutputFlagRules = []flags.Rule{
+	flags.MarkPrerequisites("output", "dry-run"),
+	flags.MarkPrerequisites("output-dir", "dry-run"),
+	flags.MarkPrerequisites("kustomize", "output-dir"),
+	flags.MarkExclusive("dry-run", "watch"),
+}
+
+// appObjects returns resources of the app in the same order and with the same content as they are applied by the push command
+func appObjects(cfg *appPushConfig, client kube.Client) ([]*unstructured.Unstructured, clierror.Error) {
+	if cfg.image == "" {
+		return nil, clierror.New(fmt.Sprintf("the dry run of the %s app requires a pre-built image", cfg.name),
+			"use the --image flag or the image field of the app manifest file to provide the image")
+	}
+
+	opts, clierr := deploymentOpts(cfg, client, cfg.image, cfg.imagePullSecretName)
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	deployment, err := resources.DeploymentObject(opts)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to build Deployment"))
+	}
+	objs := []*unstructured.Unstructured{deployment}
+
+	if ports := cfg.appPorts(); len(ports) != 0 {
+		service, err := resources.ServiceObject(cfg.name, cfg.namespace, ports)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to build Service"))
+		}
+		objs = append(objs, service)
+	}
+
+	if cfg.autoscaleMaxReplicas.Value != nil {
+		hpa, err := resources.HPAObject(cfg.hpaOpts())
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to build HorizontalPodAutoscaler"))
+		}
+		objs = append(objs, hpa)
+	}
+
+	if cfg.expose {
+		apiRule, err := resources.APIRuleObject(cfg.apiRuleOpts())
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to build APIRule"))
+		}
+		objs = append(objs, apiRule)
+	}
+
+	return objs, nil
+}
+
+// runAppPushDryRun renders resources of all apps without applying them
+func runAppPushDryRun(output *appPushOutput, printer *out.Printer, configs ...*appPushConfig) clierror.Error {
+	objs := []*unstructured.Unstructured{}
+	for _, cfg := range configs {
+		client, clierr := cfg.GetKubeClientWithClierr()
+		if clierr != nil {
+			return clierr
+		}
+
+		appObjs, clierr := appObjects(cfg, client)
+		if clierr != nil {
+			return clierr
+		}
+		objs = append(objs, appObjs...)
+	}
+
+	if output.outputDir != "" {
+		return output.write(printer, objs)
+	}
+
+	return output.print(printer, objs)
+}
+
+// print prints all resources as the multi-document YAML or the JSON List
+func (apo *appPushOutput) print(printer *out.Printer, objs []*unstructured.Unstructured) clierror.Error {
+	if apo.format == types.JSONFormat {
+		items := make([]interface{}, len(objs))
+		for i := range objs {
+			items[i] = objs[i].Object
+		}
+
+		data, err := marshalObject(map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      items,
+		}, types.JSONFormat)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to render resources"))
+		}
+
+		printer.Msg(string(data))
+		return nil
+	}
+
+	docs := make([]string, len(objs))
+	for i := range objs {
+		data, err := marshalObject(objs[i].Object, types.YAMLFormat)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to render resources"))
+		}
+		docs[i] = string(data)
+	}
+
+	printer.Msg(strings.Join(docs, "---\n"))
+	return nil
+}
+
+// write writes every resource to a separate file in the output directory
+func (apo *appPushOutput) write(printer *out.Printer, objs []*unstructured.Unstructured) clierror.Error {
+	err := os.MkdirAll(apo.outputDir, os.ModePerm)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to create the %s output directory", apo.outputDir)))
+	}
+
+	format := apo.format
+	if format == types.DefaultFormat {
+		format = types.YAMLFormat
+	}
+
+	fileNames := []string{}
+	for _, obj := range objs {
+		fileName := fmt.Sprintf("%s-%s.%s", obj.GetName(), strings.ToLower(obj.GetKind()), format)
+		if slices.Contains(fileNames, fileName) {
+			return clierror.New(fmt.Sprintf("many resources would be written to the %s file", fileName),
+				"push apps with the same name from different namespaces to separate output directories")
+		}
+
+		data, err := marshalObject(obj.Object, format)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to render %s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())))
+		}
+
+		clierr := writeOutputFile(printer, filepath.Join(apo.outputDir, fileName), data)
+		if clierr != nil {
+			return clierr
+		}
+		fileNames = append(fileNames, fileName)
+	}
+
+	if !apo.kustomize {
+		return nil
+	}
+
+	data, err := marshalObject(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  fileNames,
+	}, types.YAMLFormat)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render the kustomization file"))
+	}
+
+	return writeOutputFile(printer, filepath.Join(apo.outputDir, kustomizationFileName), data)
+}
+
+func writeOutputFile(printer *out.Printer, path string, data []byte) clierror.Error {
+	err := os.WriteFile(path, data, 0644)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to write the %s file", path)))
+	}
+
+	printer.Msgfln("Written %s", path)
+	return nil
+}
+
+func marshalObject(obj interface{}, format types.Format) ([]byte, error) {
+	if format == types.JSONFormat {
+		data, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+
+	return yaml.Marshal(obj)
+}
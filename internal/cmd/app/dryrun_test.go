@@ -0,0 +1,172 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_appObjects(t *testing.T) {
+	t.Run("objects are the same as applied by the push command", func(t *testing.T) {
+		envFile := filepath.Join(t.TempDir(), ".env")
+		require.NoError(t, os.WriteFile(envFile, []byte("SHARED=file\nFILE_ONLY=file\n"), 0600))
+		client := fixKubeClient(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "dev"},
+			Data:       map[string]string{"SHARED": "configmap", "CONFIG_ONLY": "configmap"},
+		})
+
+		cfg := fixDryRunConfig(t, client,
+			"--name", "my-app", "--namespace", "dev", "--image", "my-app:1.0.0",
+			"--port", "http=8080", "--port", "grpc=9000",
+			"--env-from-configmap", "my-config",
+			"--env-from-secret", "SECRET=my-secret:key",
+			"--env-from-file", envFile,
+			"--env", "SHARED=plain",
+			"--mount-secret", "my-secret:key=/app/secret:ro",
+			"--mount-config", "my-config",
+			"--autoscale-max-replicas", "3",
+		)
+
+		objs, clierr := appObjects(cfg, client)
+		require.Nil(t, clierr)
+		require.Nil(t, runAppPush(cfg))
+
+		applied := client.TestRootlessDynamicInterface.(*kubefake.RootlessDynamicClient).ApplyObjs
+		require.Len(t, objs, 3)
+		require.Len(t, applied, 3)
+		for i := range objs {
+			require.Equal(t, applied[i], *objs[i])
+		}
+
+		containers, _, _ := unstructured.NestedSlice(objs[0].Object, "spec", "template", "spec", "containers")
+		envs := containers[0].(map[string]interface{})["env"].([]interface{})
+		// envs are ordered by precedence to let plain envs override envs from other sources
+		require.Equal(t, map[string]interface{}{"name": "SHARED", "value": "plain"}, envs[len(envs)-1])
+		require.Contains(t, containers[0].(map[string]interface{})["volumeMounts"], map[string]interface{}{
+			"name": "secret-my-secret-0", "mountPath": "/app/secret", "readOnly": true,
+		})
+	})
+
+	t.Run("exposed app", func(t *testing.T) {
+		client := fixKubeClient()
+		cfg := fixDryRunConfig(t, client, "--name", "my-app", "--image", "my-app:1.0.0", "--container-port", "8080", "--expose")
+
+		objs, clierr := appObjects(cfg, client)
+		require.Nil(t, clierr)
+		require.Equal(t, []string{"Deployment", "Service", "APIRule"}, fixObjectKinds(objs))
+		require.Empty(t, client.TestRootlessDynamicInterface.(*kubefake.RootlessDynamicClient).ApplyObjs)
+	})
+
+	t.Run("app without image", func(t *testing.T) {
+		client := fixKubeClient()
+		cfg := fixDryRunConfig(t, client, "--name", "my-app", "--code-path", ".")
+
+		_, clierr := appObjects(cfg, client)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the dry run of the my-app app requires a pre-built image")
+	})
+}
+
+func Test_runAppPushDryRun(t *testing.T) {
+	client := fixKubeClient()
+	configs := []*appPushConfig{
+		fixDryRunConfig(t, client, "--name", "orders", "--image", "orders:1.0.0", "--container-port", "8080"),
+		fixDryRunConfig(t, client, "--name", "worker", "--image", "worker:1.0.0"),
+	}
+
+	t.Run("print yaml documents", func(t *testing.T) {
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppPushDryRun(&appPushOutput{dryRun: true}, out.NewToWriter(buf), configs...)
+		require.Nil(t, clierr)
+
+		decoder := yaml.NewDecoder(buf)
+		kinds := []string{}
+		for {
+			obj := map[string]interface{}{}
+			if decoder.Decode(&obj) != nil {
+				break
+			}
+			kinds = append(kinds, obj["kind"].(string))
+		}
+		require.Equal(t, []string{"Deployment", "Service", "Deployment"}, kinds)
+	})
+
+	t.Run("print json list", func(t *testing.T) {
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppPushDryRun(&appPushOutput{dryRun: true, format: types.JSONFormat}, out.NewToWriter(buf), configs...)
+		require.Nil(t, clierr)
+
+		list := unstructured.UnstructuredList{}
+		require.NoError(t, list.UnmarshalJSON(buf.Bytes()))
+		require.Len(t, list.Items, 3)
+		require.Equal(t, "orders", list.Items[1].GetName())
+	})
+
+	t.Run("write files with kustomization", func(t *testing.T) {
+		outputDir := filepath.Join(t.TempDir(), "deploy")
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppPushDryRun(&appPushOutput{dryRun: true, outputDir: outputDir, kustomize: true}, out.NewToWriter(buf), configs...)
+		require.Nil(t, clierr)
+
+		kustomization, err := os.ReadFile(filepath.Join(outputDir, kustomizationFileName))
+		require.NoError(t, err)
+		require.Equal(t, "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n"+
+			"    - orders-deployment.yaml\n    - orders-service.yaml\n    - worker-deployment.yaml\n", string(kustomization))
+		require.Contains(t, buf.String(), "Written "+filepath.Join(outputDir, "orders-service.yaml"))
+		require.FileExists(t, filepath.Join(outputDir, "worker-deployment.yaml"))
+	})
+
+	t.Run("write json files", func(t *testing.T) {
+		outputDir := t.TempDir()
+		clierr := runAppPushDryRun(&appPushOutput{dryRun: true, format: types.JSONFormat, outputDir: outputDir},
+			out.NewToWriter(bytes.NewBuffer([]byte{})), configs[1])
+		require.Nil(t, clierr)
+
+		data, err := os.ReadFile(filepath.Join(outputDir, "worker-deployment.json"))
+		require.NoError(t, err)
+		deployment := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(data, &deployment))
+		require.Equal(t, "Deployment", deployment["kind"])
+		require.NoFileExists(t, filepath.Join(outputDir, kustomizationFileName))
+	})
+
+	t.Run("conflicting file names", func(t *testing.T) {
+		other := fixDryRunConfig(t, client, "--name", "worker", "--namespace", "other", "--image", "worker:1.0.0")
+		clierr := runAppPushDryRun(&appPushOutput{dryRun: true, outputDir: t.TempDir()},
+			out.NewToWriter(bytes.NewBuffer([]byte{})), configs[1], other)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "many resources would be written to the worker-deployment.yaml file")
+	})
+}
+
+func fixDryRunConfig(t *testing.T, client *kubefake.KubeClient, args ...string) *appPushConfig {
+	cfg := newAppPushConfig(fixKymaConfig(client))
+	flagSet := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	cfg.addFlags(flagSet)
+	require.NoError(t, flagSet.Parse(args))
+	require.Nil(t, cfg.complete())
+	require.Nil(t, cfg.validate())
+
+	return &cfg
+}
+
+func fixObjectKinds(objs []*unstructured.Unstructured) []string {
+	kinds := []string{}
+	for _, obj := range objs {
+		kinds = append(kinds, obj.GetKind())
+	}
+
+	return kinds
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+type appListConfig struct {
+	*cmdcommon.KymaConfig
+
+	namespace     string
+	allNamespaces bool
+	outputFormat  types.Format
+}
+
+type appListItem struct {
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Ready     string `json:"ready" yaml:"ready"`
+	Image     string `json:"image" yaml:"image"`
+	Age       string `json:"age" yaml:"age"`
+}
+
+func NewAppListCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := appListConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: "Lists apps pushed to the Kubernetes cluster",
+		Long:  "Use this command to list apps pushed to the Kubernetes cluster using the 'kyma app push' command.",
+		Example: `  # List apps in the default namespace
+  kyma app list
+
+  # List apps from all namespaces in the JSON format
+  kyma app list --all-namespaces --output json`,
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runAppList(&cfg, out.Default))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Namespace from which apps are listed")
+	cmd.Flags().BoolVarP(&cfg.allNamespaces, "all-namespaces", "A", false, "Lists apps from all namespaces")
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: table, json, yaml)")
+
+	return cmd
+}
+
+func runAppList(cfg *appListConfig, printer *out.Printer) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	namespace := cfg.namespace
+	if cfg.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+
+	deployments, err := client.Static().AppsV1().Deployments(namespace).List(cfg.Ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", resources.CreatedByLabel, resources.CreatedByValue),
+	})
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to list apps"))
+	}
+
+	items := make([]appListItem, len(deployments.Items))
+	for i := range deployments.Items {
+		items[i] = buildAppListItem(&deployments.Items[i])
+	}
+	slices.SortFunc(items, func(a, b appListItem) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	err = renderAppList(printer, items, cfg.outputFormat, cfg.allNamespaces)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render apps"))
+	}
+
+	return nil
+}
+
+func buildAppListItem(deployment *appsv1.Deployment) appListItem {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	image := ""
+	if containers := deployment.Spec.Template.Spec.Containers; len(containers) > 0 {
+		image = containers[0].Image
+	}
+
+	return appListItem{
+		Name:      deployment.Name,
+		Namespace: deployment.Namespace,
+		Ready:     fmt.Sprintf("%d/%d", deployment.Status.ReadyReplicas, replicas),
+		Image:     image,
+		Age:       duration.HumanDuration(time.Since(deployment.CreationTimestamp.Time)),
+	}
+}
+
+func renderAppList(printer *out.Printer, items []appListItem, format types.Format, allNamespaces bool) error {
+	switch format {
+	case types.JSONFormat:
+		obj, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	case types.YAMLFormat:
+		obj, err := yaml.Marshal(items)
+		if err != nil {
+			return err
+		}
+		printer.Msg(string(obj))
+	default:
+		if len(items) == 0 {
+			printer.Msgln("no apps found")
+			return nil
+		}
+
+		headers := []interface{}{"NAME", "READY", "IMAGE", "AGE"}
+		if allNamespaces {
+			headers = append([]interface{}{"NAMESPACE"}, headers...)
+		}
+
+		rows := make([][]interface{}, len(items))
+		for i, item := range items {
+			rows[i] = []interface{}{item.Name, item.Ready, item.Image, item.Age}
+			if allNamespaces {
+				rows[i] = append([]interface{}{item.Namespace}, rows[i]...)
+			}
+		}
+
+		render.Table(printer.MsgWriter(), headers, rows)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_runAppList(t *testing.T) {
+	notPushed := fixAppDeployment("other", "default")
+	notPushed.Labels = nil
+	kubeClient := fixKubeClient(
+		fixAppDeployment("app-b", "default"),
+		fixAppDeployment("app-a", "default"),
+		fixAppDeployment("app-c", "dev"),
+		notPushed,
+	)
+
+	t.Run("list apps in namespace", func(t *testing.T) {
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppList(&appListConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			namespace:  "default",
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Equal(t, "NAME    READY   IMAGE                  AGE   \n"+
+			"app-a   2/2     registry/app-a:1.0.0   5m    \n"+
+			"app-b   2/2     registry/app-b:1.0.0   5m    \n", buf.String())
+	})
+
+	t.Run("list apps from all namespaces in yaml", func(t *testing.T) {
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppList(&appListConfig{
+			KymaConfig:    fixKymaConfig(kubeClient),
+			allNamespaces: true,
+			outputFormat:  types.YAMLFormat,
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Contains(t, buf.String(), "- name: app-a\n  namespace: default\n")
+		require.Contains(t, buf.String(), "- name: app-c\n  namespace: dev\n")
+		require.NotContains(t, buf.String(), "other")
+	})
+
+	t.Run("no apps found", func(t *testing.T) {
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppList(&appListConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			namespace:  "empty",
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Equal(t, "no apps found\n", buf.String())
+	})
+}
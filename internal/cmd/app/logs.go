@@ -0,0 +1,209 @@
+package app
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type appLogsConfig struct {
+	*cmdcommon.KymaConfig
+
+	name       string
+	namespace  string
+	follow     bool
+	tail       int64
+	since      time.Duration
+	timestamps bool
+	previous   bool
+}
+
+func NewAppLogsCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := appLogsConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "logs <name> [flags]",
+		Short: "Prints logs of the app",
+		Long:  "Use this command to print logs of all pods of the app pushed to the Kubernetes cluster.",
+		Example: `  # Print logs of the my-app app
+  kyma app logs my-app
+
+  # Print last 10 lines from the last hour and follow new logs
+  kyma app logs my-app --tail 10 --since 1h --follow`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.name = args[0]
+			clierror.Check(runAppLogs(&cfg, out.Default))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Namespace where the app is deployed")
+	cmd.Flags().BoolVarP(&cfg.follow, "follow", "f", false, "Streams new logs until the command is interrupted")
+	cmd.Flags().Int64Var(&cfg.tail, "tail", -1, "Number of the most recent log lines to print for each pod (prints all lines if negative)")
+	cmd.Flags().DurationVar(&cfg.since, "since", 0, "Prints only logs newer than the relative duration (e.g. 5s, 2m, or 3h)")
+	cmd.Flags().BoolVar(&cfg.timestamps, "timestamps", false, "Includes timestamps in each log line")
+	cmd.Flags().BoolVarP(&cfg.previous, "previous", "p", false, "Prints logs of the previous instance of the app container")
+
+	return cmd
+}
+
+func (c *appLogsConfig) podLogOptions() *corev1.PodLogOptions {
+	opts := &corev1.PodLogOptions{
+		Container:  c.name,
+		Follow:     c.follow,
+		Previous:   c.previous,
+		Timestamps: c.timestamps,
+	}
+
+	if c.tail >= 0 {
+		tail := c.tail
+		opts.TailLines = &tail
+	}
+
+	if c.since > 0 {
+		sinceSeconds := int64(c.since.Seconds())
+		opts.SinceSeconds = &sinceSeconds
+	}
+
+	return opts
+}
+
+func runAppLogs(cfg *appLogsConfig, printer *out.Printer) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	deployment, clierr := getApp(cfg.Ctx, client.Static(), cfg.name, cfg.namespace)
+	if clierr != nil {
+		return clierr
+	}
+
+	pods, clierr := listAppPods(cfg.Ctx, client.Static(), deployment)
+	if clierr != nil {
+		return clierr
+	}
+
+	if len(pods) == 0 {
+		return clierror.New(fmt.Sprintf("no pods found for the %s/%s app", cfg.namespace, cfg.name),
+			"use the 'kyma app status' command to check the app rollout state")
+	}
+
+	streamer := &podLogsStreamer{
+		client:  client.Static(),
+		printer: printer,
+		opts:    cfg.podLogOptions(),
+		// prefix lines with the pod name only if logs of many pods are printed
+		prefixed: len(pods) > 1,
+	}
+
+	if !cfg.follow {
+		// print logs pod by pod to keep lines of one pod together
+		for i := range pods {
+			clierr = streamer.stream(cfg.Ctx, &pods[i])
+			if clierr != nil {
+				return clierr
+			}
+		}
+		return nil
+	}
+
+	return streamer.streamAll(cfg.Ctx, pods)
+}
+
+// podLogsStreamer prints logs of the app pods line by line
+type podLogsStreamer struct {
+	client   kubernetes.Interface
+	printer  *out.Printer
+	opts     *corev1.PodLogOptions
+	prefixed bool
+
+	lock sync.Mutex
+}
+
+// streamAll streams logs of all pods concurrently and returns the first error
+func (s *podLogsStreamer) streamAll(ctx context.Context, pods []corev1.Pod) clierror.Error {
+	errs := make([]clierror.Error, len(pods))
+	wg := sync.WaitGroup{}
+	for i := range pods {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.stream(ctx, &pods[i])
+		}()
+	}
+	wg.Wait()
+
+	for _, clierr := range errs {
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	return nil
+}
+
+func (s *podLogsStreamer) stream(ctx context.Context, pod *corev1.Pod) clierror.Error {
+	logStream, err := s.client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, s.opts).Stream(ctx)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get logs stream of the %s pod", pod.Name)))
+	}
+	defer logStream.Close()
+
+	err = s.printLines(pod.Name, logStream)
+	if err != nil && ctx.Err() == nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to read logs of the %s pod", pod.Name)))
+	}
+
+	return nil
+}
+
+// printLines prints logs line by line without limiting the line length
+func (s *podLogsStreamer) printLines(podName string, logStream io.Reader) error {
+	return readLines(logStream, func(line string) {
+		s.println(podName, line)
+	})
+}
+
+// readLines passes lines from the reader to the handler without limiting the line length
+func readLines(r io.Reader, handleLine func(string)) error {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			handleLine(strings.TrimSuffix(line, "\n"))
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *podLogsStreamer) println(podName, line string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.prefixed {
+		s.printer.Msgfln("[%s] %s", podName, line)
+		return
+	}
+
+	s.printer.Msgln(line)
+}
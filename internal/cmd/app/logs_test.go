@@ -0,0 +1,105 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func Test_runAppLogs(t *testing.T) {
+	t.Run("print logs of the only pod", func(t *testing.T) {
+		kubeClient := fixKubeClient(
+			fixAppDeployment("my-app", "default"),
+			fixAppPod("my-app-1", "my-app", "default"),
+		)
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppLogs(&appLogsConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Equal(t, "fake logs\n", buf.String())
+	})
+
+	t.Run("follow logs of many pods", func(t *testing.T) {
+		kubeClient := fixKubeClient(
+			fixAppDeployment("my-app", "default"),
+			fixAppPod("my-app-1", "my-app", "default"),
+			fixAppPod("my-app-2", "my-app", "default"),
+		)
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppLogs(&appLogsConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			name:       "my-app",
+			namespace:  "default",
+			follow:     true,
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Contains(t, buf.String(), "[my-app-1] fake logs\n")
+		require.Contains(t, buf.String(), "[my-app-2] fake logs\n")
+	})
+
+	t.Run("no pods found", func(t *testing.T) {
+		clierr := runAppLogs(&appLogsConfig{
+			KymaConfig: fixKymaConfig(fixKubeClient(fixAppDeployment("my-app", "default"))),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(bytes.NewBuffer([]byte{})))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "no pods found for the default/my-app app")
+	})
+}
+
+func Test_podLogsStreamer_printLines(t *testing.T) {
+	longLine := strings.Repeat("a", 100*1024)
+	buf := bytes.NewBuffer([]byte{})
+	streamer := &podLogsStreamer{
+		printer:  out.NewToWriter(buf),
+		prefixed: true,
+	}
+
+	err := streamer.printLines("my-app-1", strings.NewReader("first\n"+longLine+"\nlast"))
+	require.NoError(t, err)
+	require.Equal(t, "[my-app-1] first\n[my-app-1] "+longLine+"\n[my-app-1] last\n", buf.String())
+}
+
+func Test_readLines(t *testing.T) {
+	longLine := strings.Repeat("a", 100*1024)
+	lines := []string{}
+
+	err := readLines(strings.NewReader(longLine+"\nlast\n"), func(line string) {
+		lines = append(lines, line)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{longLine, "last"}, lines)
+}
+
+func Test_appLogsConfig_podLogOptions(t *testing.T) {
+	cfg := appLogsConfig{
+		name:       "my-app",
+		follow:     true,
+		tail:       10,
+		since:      time.Hour,
+		timestamps: true,
+	}
+
+	require.Equal(t, &corev1.PodLogOptions{
+		Container:    "my-app",
+		Follow:       true,
+		Timestamps:   true,
+		TailLines:    ptr.To(int64(10)),
+		SinceSeconds: ptr.To(int64(3600)),
+	}, cfg.podLogOptions())
+
+	cfg = appLogsConfig{name: "my-app", tail: -1}
+	require.Equal(t, &corev1.PodLogOptions{Container: "my-app"}, cfg.podLogOptions())
+}
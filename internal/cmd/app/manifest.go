@@ -0,0 +1,422 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// matches escaped dollar sign ($$) or env reference in format ${NAME} or ${NAME:-default}
+var manifestEnvRegexp = regexp.MustCompile(`\$\$|\$\{([a-zA-Z_][a-zA-Z0-9_]*)(:-([^}]*))?\}`)
+
+// appManifest describes apps to push in the kyma-app.yaml file
+type appManifest struct {
+	Apps []appManifestApp `yaml:"apps"`
+}
+
+// appManifestApp mirrors flags of the app push command
+type appManifestApp struct {
+	Name                      string            `yaml:"name"`
+	Namespace                 string            `yaml:"namespace"`
+	Image                     string            `yaml:"image"`
+	ImagePullSecret           string            `yaml:"imagePullSecret"`
+	BuildTag                  string            `yaml:"buildTag"`
+	Dockerfile                string            `yaml:"dockerfile"`
+	DockerfileContext         string            `yaml:"dockerfileContext"`
+	DockerfileBuildArgs       map[string]string `yaml:"dockerfileBuildArgs"`
+	CodePath                  string            `yaml:"codePath"`
+	ContainerPort             *int64            `yaml:"containerPort"`
+	Ports                     map[string]int64  `yaml:"ports"`
+	IstioInject               *bool             `yaml:"istioInject"`
+	Expose                    *bool             `yaml:"expose"`
+	ExposePort                string            `yaml:"exposePort"`
+	ExposeHost                string            `yaml:"exposeHost"`
+	ExposeMethod              []string          `yaml:"exposeMethod"`
+	ExposePath                []string          `yaml:"exposePath"`
+	JWTIssuer                 string            `yaml:"jwtIssuer"`
+	JWTJWKSURI                string            `yaml:"jwtJwksUri"`
+	ExtAuthAuthorizer         []string          `yaml:"extAuthAuthorizer"`
+	CORSAllowOrigin           []string          `yaml:"corsAllowOrigin"`
+	CORSAllowMethod           []string          `yaml:"corsAllowMethod"`
+	CORSAllowHeader           []string          `yaml:"corsAllowHeader"`
+	CORSAllowCredentials      *bool             `yaml:"corsAllowCredentials"`
+	CORSMaxAge                string            `yaml:"corsMaxAge"`
+	Insecure                  *bool             `yaml:"insecure"`
+	Env                       map[string]string `yaml:"env"`
+	EnvFromFile               []string          `yaml:"envFromFile"`
+	EnvFromConfigmap          []string          `yaml:"envFromConfigmap"`
+	EnvFromSecret             []string          `yaml:"envFromSecret"`
+	MountSecret               []string          `yaml:"mountSecret"`
+	MountConfig               []string          `yaml:"mountConfig"`
+	MountServiceBindingSecret []string          `yaml:"mountServiceBindingSecret"`
+	Replicas                  *int64            `yaml:"replicas"`
+	CPURequest                string            `yaml:"cpuRequest"`
+	CPULimit                  string            `yaml:"cpuLimit"`
+	MemoryRequest             string            `yaml:"memoryRequest"`
+	MemoryLimit               string            `yaml:"memoryLimit"`
+	LivenessProbePath         string            `yaml:"livenessProbePath"`
+	ReadinessProbePath        string            `yaml:"readinessProbePath"`
+	AutoscaleMinReplicas      *int64            `yaml:"autoscaleMinReplicas"`
+	AutoscaleMaxReplicas      *int64            `yaml:"autoscaleMaxReplicas"`
+	AutoscaleCPUUtilization   *int64            `yaml:"autoscaleCpuUtilization"`
+}
+
+// displayName returns the app name or its position in the manifest if the name is not set
+func (a *appManifestApp) displayName(index int) string {
+	if a.Name != "" {
+		return a.Name
+	}
+
+	return fmt.Sprintf("#%d", index+1)
+}
+
+// manifestFlag contains values of the manifest field passed to the flag with the same meaning
+type manifestFlag struct {
+	field  string
+	flag   string
+	values []string
+}
+
+// flags returns values of all fields set in the manifest in the same order as they are defined
+func (a *appManifestApp) flags() []manifestFlag {
+	manifestFlags := []manifestFlag{}
+	add := func(field, flag string, values ...string) {
+		if len(values) != 0 {
+			manifestFlags = append(manifestFlags, manifestFlag{field: field, flag: flag, values: values})
+		}
+	}
+
+	add("name", "name", nonEmpty(a.Name)...)
+	add("namespace", "namespace", nonEmpty(a.Namespace)...)
+	add("image", "image", nonEmpty(a.Image)...)
+	add("imagePullSecret", "image-pull-secret", nonEmpty(a.ImagePullSecret)...)
+	add("buildTag", "build-tag", nonEmpty(a.BuildTag)...)
+	add("dockerfile", "dockerfile", nonEmpty(a.Dockerfile)...)
+	add("dockerfileContext", "dockerfile-context", nonEmpty(a.DockerfileContext)...)
+	add("dockerfileBuildArgs", "dockerfile-build-arg", keyValues(a.DockerfileBuildArgs, "%s=%s")...)
+	add("codePath", "code-path", nonEmpty(a.CodePath)...)
+	add("containerPort", "container-port", nullableInt(a.ContainerPort)...)
+	add("ports", "port", portValues(a.Ports)...)
+	if a.IstioInject != nil {
+		add("istioInject", "istio-inject", strconv.FormatBool(*a.IstioInject))
+	}
+	if a.Expose != nil {
+		add("expose", "expose", strconv.FormatBool(*a.Expose))
+	}
+	add("exposePort", "expose-port", nonEmpty(a.ExposePort)...)
+	add("exposeHost", "expose-host", nonEmpty(a.ExposeHost)...)
+	add("exposeMethod", "expose-method", a.ExposeMethod...)
+	add("exposePath", "expose-path", a.ExposePath...)
+	add("jwtIssuer", "jwt-issuer", nonEmpty(a.JWTIssuer)...)
+	add("jwtJwksUri", "jwt-jwks-uri", nonEmpty(a.JWTJWKSURI)...)
+	add("extAuthAuthorizer", "ext-auth-authorizer", a.ExtAuthAuthorizer...)
+	add("corsAllowOrigin", "cors-allow-origin", a.CORSAllowOrigin...)
+	add("corsAllowMethod", "cors-allow-method", a.CORSAllowMethod...)
+	add("corsAllowHeader", "cors-allow-header", a.CORSAllowHeader...)
+	if a.CORSAllowCredentials != nil {
+		add("corsAllowCredentials", "cors-allow-credentials", strconv.FormatBool(*a.CORSAllowCredentials))
+	}
+	add("corsMaxAge", "cors-max-age", nonEmpty(a.CORSMaxAge)...)
+	if a.Insecure != nil {
+		add("insecure", "insecure", strconv.FormatBool(*a.Insecure))
+	}
+	// use the strict format to allow commas and equal signs in values
+	add("env", "env", keyValues(a.Env, "name=%s,value=%s")...)
+	add("envFromFile", "env-from-file", a.EnvFromFile...)
+	add("envFromConfigmap", "env-from-configmap", a.EnvFromConfigmap...)
+	add("envFromSecret", "env-from-secret", a.EnvFromSecret...)
+	add("mountSecret", "mount-secret", a.MountSecret...)
+	add("mountConfig", "mount-config", a.MountConfig...)
+	add("mountServiceBindingSecret", "mount-service-binding-secret", a.MountServiceBindingSecret...)
+	add("replicas", "replicas", nullableInt(a.Replicas)...)
+	add("cpuRequest", "cpu-request", nonEmpty(a.CPURequest)...)
+	add("cpuLimit", "cpu-limit", nonEmpty(a.CPULimit)...)
+	add("memoryRequest", "memory-request", nonEmpty(a.MemoryRequest)...)
+	add("memoryLimit", "memory-limit", nonEmpty(a.MemoryLimit)...)
+	add("livenessProbePath", "liveness-probe-path", nonEmpty(a.LivenessProbePath)...)
+	add("readinessProbePath", "readiness-probe-path", nonEmpty(a.ReadinessProbePath)...)
+	add("autoscaleMinReplicas", "autoscale-min-replicas", nullableInt(a.AutoscaleMinReplicas)...)
+	add("autoscaleMaxReplicas", "autoscale-max-replicas", nullableInt(a.AutoscaleMaxReplicas)...)
+	add("autoscaleCpuUtilization", "autoscale-cpu-utilization", nullableInt(a.AutoscaleCPUUtilization)...)
+
+	return manifestFlags
+}
+
+func nonEmpty(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return []string{value}
+}
+
+// portValues returns ports in the NAME=PORT format sorted by names
+func portValues(ports map[string]int64) []string {
+	values := make(map[string]string, len(ports))
+	for name, port := range ports {
+		values[name] = strconv.FormatInt(port, 10)
+	}
+
+	return keyValues(values, "%s=%s")
+}
+
+func nullableInt(value *int64) []string {
+	if value == nil {
+		return nil
+	}
+
+	return []string{strconv.FormatInt(*value, 10)}
+}
+
+// keyValues returns map elements in the given format sorted by keys
+func keyValues(values map[string]string, format string) []string {
+	result := []string{}
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		result = append(result, fmt.Sprintf(format, key, values[key]))
+	}
+
+	return result
+}
+
+// readAppManifest reads the manifest file and replaces env references in its values with their values
+func readAppManifest(path string) (*appManifest, clierror.Error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to read the app manifest file"))
+	}
+
+	// parse the manifest before interpolation so env values can't change its structure
+	node := &yaml.Node{}
+	err = yaml.Unmarshal(data, node)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to parse the app manifest file"))
+	}
+
+	err = interpolateNodeEnvs(node, os.LookupEnv)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to interpolate envs in the app manifest file",
+			"set missing environment variables or provide default values in the ${NAME:-default} format"))
+	}
+
+	manifest := &appManifest{}
+	if len(node.Content) != 0 {
+		err = decodeKnownFields(node, manifest)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to parse the app manifest file"))
+		}
+	}
+
+	if len(manifest.Apps) == 0 {
+		return nil, clierror.New("no apps defined in the app manifest file", "define apps under the 'apps' field")
+	}
+
+	return manifest, nil
+}
+
+// decodeKnownFields decodes the node into the out value and fails on fields not defined in it
+func decodeKnownFields(node *yaml.Node, out interface{}) error {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	return decoder.Decode(out)
+}
+
+// interpolateNodeEnvs replaces env references in all scalar values of the node, keys and comments are left as they are
+func interpolateNodeEnvs(node *yaml.Node, lookupEnv func(string) (string, bool)) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		value, err := interpolateEnvs(node.Value, lookupEnv)
+		if value != node.Value && node.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			// resolve the type of the plain value again, for example, to decode ${PORT} as a number
+			node.Tag = ""
+		}
+		node.Value = value
+		return err
+	case yaml.MappingNode:
+		errs := []error{}
+		for i := 1; i < len(node.Content); i += 2 {
+			errs = append(errs, interpolateNodeEnvs(node.Content[i], lookupEnv))
+		}
+		return errors.Join(errs...)
+	default:
+		errs := []error{}
+		for _, child := range node.Content {
+			errs = append(errs, interpolateNodeEnvs(child, lookupEnv))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// interpolateEnvs replaces env references in format ${NAME} or ${NAME:-default} with their values and $$ with $
+func interpolateEnvs(value string, lookupEnv func(string) (string, bool)) (string, error) {
+	missingEnvs := []error{}
+	result := manifestEnvRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		if match == "$$" {
+			return "$"
+		}
+
+		groups := manifestEnvRegexp.FindStringSubmatch(match)
+		if value, ok := lookupEnv(groups[1]); ok {
+			return value
+		}
+		if groups[2] != "" {
+			// use default value
+			return groups[3]
+		}
+
+		missingEnvs = append(missingEnvs, fmt.Errorf("environment variable '%s' is not set", groups[1]))
+		return match
+	})
+
+	return result, errors.Join(missingEnvs...)
+}
+
+// runAppPushManifest validates all apps defined in the manifest and pushes them one by one or renders resources of all of them in the dry run
+func runAppPushManifest(kymaConfig *cmdcommon.KymaConfig, cmdFlags *pflag.FlagSet, manifestPath string, output *appPushOutput) clierror.Error {
+	manifest, clierr := readAppManifest(manifestPath)
+	if clierr != nil {
+		return clierr
+	}
+
+	apps, clierr := selectManifestApps(manifest, cmdFlags)
+	if clierr != nil {
+		return clierr
+	}
+
+	configs := make([]*appPushConfig, len(apps))
+	for i := range apps {
+		configs[i], clierr = buildManifestAppConfig(kymaConfig, cmdFlags, &apps[i])
+		if clierr != nil {
+			return clierror.WrapE(clierr, clierror.New(fmt.Sprintf("invalid configuration of the %s app in the app manifest file", apps[i].displayName(i))))
+		}
+	}
+
+	if output.dryRun {
+		return runAppPushDryRun(output, out.Default, configs...)
+	}
+
+	for _, config := range configs {
+		clierr = runAppPush(config)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	return nil
+}
+
+// selectManifestApps returns the only app with name given in the name flag if the manifest defines many apps
+func selectManifestApps(manifest *appManifest, cmdFlags *pflag.FlagSet) ([]appManifestApp, clierror.Error) {
+	nameFlag := cmdFlags.Lookup("name")
+	if len(manifest.Apps) == 1 || !nameFlag.Changed {
+		return manifest.Apps, nil
+	}
+
+	for _, app := range manifest.Apps {
+		if app.Name == nameFlag.Value.String() {
+			return []appManifestApp{app}, nil
+		}
+	}
+
+	return nil, clierror.New(fmt.Sprintf("app '%s' not found in the app manifest file", nameFlag.Value.String()))
+}
+
+// buildManifestAppConfig builds the app push config from flags used in the command line and fields of the manifest app
+func buildManifestAppConfig(kymaConfig *cmdcommon.KymaConfig, cmdFlags *pflag.FlagSet, app *appManifestApp) (*appPushConfig, clierror.Error) {
+	config := newAppPushConfig(kymaConfig)
+	flagSet := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	config.addFlags(flagSet)
+
+	// flags used in the command line override values from the manifest
+	var err error
+	cmdFlags.Visit(func(flag *pflag.Flag) {
+		if flagSet.Lookup(flag.Name) == nil || err != nil {
+			return
+		}
+
+		for _, value := range recordedFlagValues(flag) {
+			err = flagSet.Set(flag.Name, value)
+			if err != nil {
+				err = fmt.Errorf("invalid argument '%s' for the '--%s' flag: %w", value, flag.Name, err)
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to set flags"))
+	}
+
+	for _, manifestFlag := range app.flags() {
+		if flagSet.Changed(manifestFlag.flag) {
+			continue
+		}
+
+		for _, value := range manifestFlag.values {
+			err = flagSet.Set(manifestFlag.flag, value)
+			if err != nil {
+				return nil, clierror.Wrap(err, clierror.New(fmt.Sprintf("invalid value of the '%s' field", manifestFlag.field)))
+			}
+		}
+	}
+
+	clierr := config.complete()
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	clierr = flags.Validate(flagSet, appPushFlagRules...)
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	clierr = config.validate()
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	return &config, nil
+}
+
+// recordedValue remembers raw values set to the flag to apply them to flags of every app from the manifest
+type recordedValue struct {
+	pflag.Value
+
+	values []string
+}
+
+func (v *recordedValue) Set(value string) error {
+	v.values = append(v.values, value)
+	return v.Value.Set(value)
+}
+
+// recordFlagValues wraps values of all flags to remember raw values passed in the command line
+func recordFlagValues(flagSet *pflag.FlagSet) {
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		flag.Value = &recordedValue{Value: flag.Value}
+	})
+}
+
+func recordedFlagValues(flag *pflag.Flag) []string {
+	value, ok := flag.Value.(*recordedValue)
+	if !ok {
+		return []string{flag.Value.String()}
+	}
+
+	return value.values
+}
@@ -0,0 +1,242 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func Test_interpolateEnvs(t *testing.T) {
+	lookupEnv := func(name string) (string, bool) {
+		value, ok := map[string]string{"TAG": "1.0.0", "EMPTY": ""}[name]
+		return value, ok
+	}
+
+	t.Run("replace envs", func(t *testing.T) {
+		value, err := interpolateEnvs("app:${TAG} ${EMPTY:-x}-${PORT:-8080} $$ecret$TAG", lookupEnv)
+		require.NoError(t, err)
+		require.Equal(t, "app:1.0.0 -8080 $ecret$TAG", value)
+	})
+
+	t.Run("missing envs", func(t *testing.T) {
+		_, err := interpolateEnvs("${IMAGE}:${TAG}:${PORT}", lookupEnv)
+		require.EqualError(t, err, "environment variable 'IMAGE' is not set\nenvironment variable 'PORT' is not set")
+	})
+}
+
+func Test_readAppManifest(t *testing.T) {
+	t.Setenv("APP_TAG", "abc1234")
+
+	t.Run("read manifest with many apps", func(t *testing.T) {
+		path := fixManifestFile(t, `apps:
+  - name: backend
+    codePath: ./backend
+    buildTag: ${APP_TAG}
+    containerPort: 8080
+    expose: true
+    env:
+      DB_URL: postgres://db:5432/app?ssl=true,timeout=5
+  - name: frontend
+    image: nginx:${NGINX_TAG:-latest}
+`)
+
+		manifest, clierr := readAppManifest(path)
+		require.Nil(t, clierr)
+		require.Equal(t, &appManifest{
+			Apps: []appManifestApp{
+				{
+					Name:          "backend",
+					CodePath:      "./backend",
+					BuildTag:      "abc1234",
+					ContainerPort: ptr.To(int64(8080)),
+					Expose:        ptr.To(true),
+					Env:           map[string]string{"DB_URL": "postgres://db:5432/app?ssl=true,timeout=5"},
+				},
+				{
+					Name:  "frontend",
+					Image: "nginx:latest",
+				},
+			},
+		}, manifest)
+	})
+
+	t.Run("env values are not parsed as yaml", func(t *testing.T) {
+		t.Setenv("APP_DESCRIPTION", "key: value # not a comment")
+		t.Setenv("APP_PORT", "8080")
+		path := fixManifestFile(t, `apps:
+  - name: app
+    containerPort: ${APP_PORT}
+    env:
+      DESCRIPTION: ${APP_DESCRIPTION}
+`)
+
+		manifest, clierr := readAppManifest(path)
+		require.Nil(t, clierr)
+		require.Equal(t, ptr.To(int64(8080)), manifest.Apps[0].ContainerPort)
+		require.Equal(t, map[string]string{"DESCRIPTION": "key: value # not a comment"}, manifest.Apps[0].Env)
+	})
+
+	t.Run("skip env references in comments", func(t *testing.T) {
+		path := fixManifestFile(t, `# the image tag is set by ${MISSING_IMAGE_TAG}
+apps:
+  - name: app # ${MISSING_APP_NAME}
+`)
+
+		manifest, clierr := readAppManifest(path)
+		require.Nil(t, clierr)
+		require.Equal(t, []appManifestApp{{Name: "app"}}, manifest.Apps)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, clierr := readAppManifest(fixManifestFile(t, "apps:\n  - name: app\n    port: 8080\n"))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to parse the app manifest file")
+		require.Contains(t, clierr.String(), "field port not found")
+	})
+
+	t.Run("no apps", func(t *testing.T) {
+		_, clierr := readAppManifest(fixManifestFile(t, "apps: []\n"))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "no apps defined in the app manifest file")
+	})
+
+	t.Run("missing env", func(t *testing.T) {
+		_, clierr := readAppManifest(fixManifestFile(t, "apps:\n  - name: ${MISSING_APP_NAME}\n"))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "environment variable 'MISSING_APP_NAME' is not set")
+	})
+}
+
+func Test_buildManifestAppConfig(t *testing.T) {
+	kymaConfig := &cmdcommon.KymaConfig{}
+
+	t.Run("flags override manifest values", func(t *testing.T) {
+		cmd := NewAppPushCMD(kymaConfig)
+		require.NoError(t, cmd.ParseFlags([]string{"-f", "kyma-app.yaml", "-n", "dev", "--env", "B=cli", "--env", "C=cli"}))
+
+		config, clierr := buildManifestAppConfig(kymaConfig, cmd.Flags(), &appManifestApp{
+			Name:          "my-app",
+			Namespace:     "default",
+			Image:         "my-app:1.0.0",
+			ContainerPort: ptr.To(int64(8080)),
+			Expose:        ptr.To(true),
+			Env:           map[string]string{"A": "manifest", "B": "manifest"},
+		})
+		require.Nil(t, clierr)
+		require.Equal(t, "my-app", config.name)
+		require.Equal(t, "dev", config.namespace)
+		require.Equal(t, "my-app:1.0.0", config.image)
+		require.Equal(t, int64(8080), *config.containerPort.Value)
+		require.True(t, config.expose)
+		require.Equal(t, map[string]interface{}{"B": "cli", "C": "cli"}, config.envs.Values)
+	})
+
+	t.Run("validate flags rules", func(t *testing.T) {
+		cmd := NewAppPushCMD(kymaConfig)
+		require.NoError(t, cmd.ParseFlags([]string{"-f", "kyma-app.yaml", "--code-path", "."}))
+
+		_, clierr := buildManifestAppConfig(kymaConfig, cmd.Flags(), &appManifestApp{
+			Name:   "my-app",
+			Image:  "my-app:1.0.0",
+			Expose: ptr.To(true),
+		})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "exactly one from group [image dockerfile code-path] must be set, used [code-path image]")
+		require.Contains(t, clierr.String(), "at least one of the flags from the group [container-port port] must be set when [expose] flag is used")
+	})
+
+	t.Run("invalid manifest value", func(t *testing.T) {
+		cmd := NewAppPushCMD(kymaConfig)
+		require.NoError(t, cmd.ParseFlags([]string{"-f", "kyma-app.yaml"}))
+
+		_, clierr := buildManifestAppConfig(kymaConfig, cmd.Flags(), &appManifestApp{
+			Name:        "my-app",
+			Image:       "my-app:1.0.0",
+			MountSecret: []string{"secret:key=/app/../etc"},
+		})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid value of the 'mountSecret' field")
+	})
+}
+
+func Test_selectManifestApps(t *testing.T) {
+	manifest := &appManifest{
+		Apps: []appManifestApp{{Name: "backend"}, {Name: "frontend"}},
+	}
+
+	t.Run("select all apps", func(t *testing.T) {
+		cmd := NewAppPushCMD(&cmdcommon.KymaConfig{})
+		require.NoError(t, cmd.ParseFlags([]string{"-f", "kyma-app.yaml"}))
+
+		apps, clierr := selectManifestApps(manifest, cmd.Flags())
+		require.Nil(t, clierr)
+		require.Equal(t, manifest.Apps, apps)
+	})
+
+	t.Run("select app by name", func(t *testing.T) {
+		cmd := NewAppPushCMD(&cmdcommon.KymaConfig{})
+		require.NoError(t, cmd.ParseFlags([]string{"-f", "kyma-app.yaml", "--name", "frontend"}))
+
+		apps, clierr := selectManifestApps(manifest, cmd.Flags())
+		require.Nil(t, clierr)
+		require.Equal(t, []appManifestApp{{Name: "frontend"}}, apps)
+	})
+
+	t.Run("app not found", func(t *testing.T) {
+		cmd := NewAppPushCMD(&cmdcommon.KymaConfig{})
+		require.NoError(t, cmd.ParseFlags([]string{"-f", "kyma-app.yaml", "--name", "db"}))
+
+		_, clierr := selectManifestApps(manifest, cmd.Flags())
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app 'db' not found in the app manifest file")
+	})
+}
+
+func Test_appManifestApp_flags(t *testing.T) {
+	app := appManifestApp{
+		Name:                "my-app",
+		Dockerfile:          "./Dockerfile",
+		DockerfileBuildArgs: map[string]string{"B": "2", "A": "1"},
+		IstioInject:         ptr.To(false),
+		EnvFromSecret:       []string{"my-secret:SECRET_"},
+	}
+
+	require.Equal(t, []manifestFlag{
+		{field: "name", flag: "name", values: []string{"my-app"}},
+		{field: "dockerfile", flag: "dockerfile", values: []string{"./Dockerfile"}},
+		{field: "dockerfileBuildArgs", flag: "dockerfile-build-arg", values: []string{"A=1", "B=2"}},
+		{field: "istioInject", flag: "istio-inject", values: []string{"false"}},
+		{field: "envFromSecret", flag: "env-from-secret", values: []string{"my-secret:SECRET_"}},
+	}, app.flags())
+
+	// make sure all fields are mapped to flags of the push command
+	allFields := appManifestApp{
+		Name: "a", Namespace: "a", Image: "a", ImagePullSecret: "a", BuildTag: "a", Dockerfile: "a",
+		DockerfileContext: "a", DockerfileBuildArgs: map[string]string{"a": "a"}, CodePath: "a",
+		ContainerPort: ptr.To(int64(1)), IstioInject: ptr.To(true), Expose: ptr.To(true), Insecure: ptr.To(true),
+		Env: map[string]string{"a": "a"}, EnvFromFile: []string{"a"}, EnvFromConfigmap: []string{"a"},
+		EnvFromSecret: []string{"a"}, MountSecret: []string{"a"}, MountConfig: []string{"a"},
+		MountServiceBindingSecret: []string{"a"}, Replicas: ptr.To(int64(1)), CPURequest: "a", CPULimit: "a",
+		MemoryRequest: "a", MemoryLimit: "a", LivenessProbePath: "a", ReadinessProbePath: "a",
+		AutoscaleMinReplicas: ptr.To(int64(1)), AutoscaleMaxReplicas: ptr.To(int64(1)), AutoscaleCPUUtilization: ptr.To(int64(1)),
+		Ports: map[string]int64{"http": 8080}, ExposePort: "http",
+		ExposeHost: "a", ExposeMethod: []string{"a"}, ExposePath: []string{"a"}, JWTIssuer: "a", JWTJWKSURI: "a",
+		ExtAuthAuthorizer: []string{"a"}, CORSAllowOrigin: []string{"a"}, CORSAllowMethod: []string{"a"},
+		CORSAllowHeader: []string{"a"}, CORSAllowCredentials: ptr.To(true), CORSMaxAge: "a",
+	}
+	flagSet := NewAppPushCMD(&cmdcommon.KymaConfig{}).Flags()
+	require.Len(t, allFields.flags(), 43)
+	for _, manifestFlag := range allFields.flags() {
+		require.NotNil(t, flagSet.Lookup(manifestFlag.flag), manifestFlag.flag)
+	}
+}
+
+func fixManifestFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "kyma-app.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/portforward"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type appPortForwardConfig struct {
+	*cmdcommon.KymaConfig
+
+	name        string
+	namespace   string
+	address     string
+	portMapping string
+}
+
+// portMapping describes the local port forwarded to the app port
+type portMapping struct {
+	// local port number, 0 selects a random port and nil selects the same port as the remote one
+	local *int
+	// remote port number or name, empty selects the primary port of the app
+	remote string
+}
+
+func NewAppPortForwardCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := appPortForwardConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "port-forward <name> [[LOCAL:]REMOTE] [flags]",
+		Short: "Forwards a local port to the app",
+		Long: `Use this command to forward connections from a local port to the port of the app pushed to the Kubernetes cluster.
+Every connection is forwarded to a ready pod selected by the app labels, so the command reconnects to new pods when the app pods restart.
+The remote port can be the port number or name. By default, the command forwards the same local port to the port named http or the first port of the app.`,
+		Example: `  # Forward the local port to the same port of the my-app app
+  kyma app port-forward my-app
+
+  # Forward the local port 9000 to the port 8080 of the app
+  kyma app port-forward my-app 9000:8080
+
+  # Forward a random local port to the port named metrics
+  kyma app port-forward my-app :metrics`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.name = args[0]
+			if len(args) > 1 {
+				cfg.portMapping = args[1]
+			}
+			clierror.Check(runAppPortForward(&cfg, out.Default))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Namespace where the app is deployed")
+	cmd.Flags().StringVar(&cfg.address, "address", "localhost", "Local address to listen on")
+
+	return cmd
+}
+
+func runAppPortForward(cfg *appPortForwardConfig, printer *out.Printer) clierror.Error {
+	mapping, err := parsePortMapping(cfg.portMapping)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("invalid port mapping '%s'", cfg.portMapping),
+			"use the [LOCAL:]REMOTE format, for example 8080, 9000:8080, or :metrics"))
+	}
+
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	deployment, clierr := getApp(cfg.Ctx, client.Static(), cfg.name, cfg.namespace)
+	if clierr != nil {
+		return clierr
+	}
+
+	remotePort, clierr := resolveAppPort(deployment, mapping.remote)
+	if clierr != nil {
+		return clierr
+	}
+
+	localPort := int(remotePort)
+	if mapping.local != nil {
+		localPort = *mapping.local
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(cfg.address, strconv.Itoa(localPort)))
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to listen on %s", net.JoinHostPort(cfg.address, strconv.Itoa(localPort))),
+			"make sure the port is not used by another process"))
+	}
+
+	ctx, stop := signal.NotifyContext(cfg.Ctx, os.Interrupt)
+	defer stop()
+
+	printer.Msgfln("Forwarding %s to port %d of the %s/%s app, press Ctrl+C to stop", listener.Addr(), remotePort, cfg.namespace, cfg.name)
+	err = forwardAppPort(ctx, client, printer, cfg.name, cfg.namespace, listener, remotePort)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to forward connections to the app"))
+	}
+
+	return nil
+}
+
+// parsePortMapping parses the port mapping in the [LOCAL:]REMOTE format
+func parsePortMapping(value string) (portMapping, error) {
+	local, remote, found := strings.Cut(value, ":")
+	if !found {
+		// the same port number is used locally
+		return portMapping{remote: value}, nil
+	}
+
+	if remote == "" {
+		return portMapping{}, fmt.Errorf("missing remote port")
+	}
+
+	localPort := 0
+	if local != "" {
+		port, err := strconv.Atoi(local)
+		if err != nil || validation.IsValidPortNum(port) != nil {
+			return portMapping{}, fmt.Errorf("invalid local port number '%s', should be between 1 and 65535", local)
+		}
+		localPort = port
+	}
+
+	return portMapping{local: &localPort, remote: remote}, nil
+}
+
+// resolveAppPort returns the number of the app port with the given number or name
+func resolveAppPort(deployment *appsv1.Deployment, remote string) (int32, clierror.Error) {
+	ports := appContainerPorts(deployment)
+
+	if port, err := strconv.Atoi(remote); err == nil {
+		if validation.IsValidPortNum(port) != nil {
+			return 0, clierror.New(fmt.Sprintf("invalid remote port number '%s'", remote), "port must be between 1 and 65535")
+		}
+		return int32(port), nil
+	}
+
+	if remote == "" {
+		if len(ports) == 0 {
+			return 0, clierror.New(fmt.Sprintf("the %s/%s app has no ports", deployment.Namespace, deployment.Name),
+				"provide the remote port number in the [LOCAL:]REMOTE format")
+		}
+
+		if primary := resources.PrimaryHTTPPort(ports); primary != nil {
+			return primary.Port, nil
+		}
+		return ports[0].Port, nil
+	}
+
+	portNames := []string{}
+	for _, port := range ports {
+		if port.Name == remote {
+			return port.Port, nil
+		}
+		portNames = append(portNames, port.Name)
+	}
+
+	hint := "provide the remote port number in the [LOCAL:]REMOTE format"
+	if len(portNames) != 0 {
+		hint = fmt.Sprintf("use the name of one of the app ports: %s", strings.Join(portNames, ", "))
+	}
+	return 0, clierror.New(fmt.Sprintf("the %s/%s app has no port named '%s'", deployment.Namespace, deployment.Name, remote), hint)
+}
+
+// appContainerPorts returns named ports of the app container
+func appContainerPorts(deployment *appsv1.Deployment) []types.PortSpec {
+	ports := []types.PortSpec{}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name != deployment.Name {
+			continue
+		}
+
+		for _, port := range container.Ports {
+			ports = append(ports, types.PortSpec{Name: port.Name, Port: port.ContainerPort})
+		}
+	}
+
+	return ports
+}
+
+// forwardAppPort forwards connections accepted by the listener to the port of the ready app pod until the context is done
+func forwardAppPort(ctx context.Context, client kube.Client, printer *out.Printer, name, namespace string, listener net.Listener, remotePort int32) error {
+	forwarder := portforward.NewForwarder(client.RestConfig(), namespace, remotePort, func(ctx context.Context) (string, error) {
+		return getReadyAppPod(ctx, client.Static(), name, namespace)
+	})
+
+	return forwarder.Serve(ctx, listener, func(err error) {
+		printer.Errln(err.Error())
+	})
+}
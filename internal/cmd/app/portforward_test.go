@@ -0,0 +1,113 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func Test_runAppPortForward(t *testing.T) {
+	t.Run("invalid port mapping", func(t *testing.T) {
+		clierr := runAppPortForward(&appPortForwardConfig{
+			KymaConfig:  fixKymaConfig(fixKubeClient()),
+			name:        "my-app",
+			namespace:   "default",
+			portMapping: "local:8080",
+		}, out.NewToWriter(bytes.NewBuffer([]byte{})))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid port mapping 'local:8080'")
+		require.Contains(t, clierr.String(), "invalid local port number 'local', should be between 1 and 65535")
+	})
+
+	t.Run("app not found", func(t *testing.T) {
+		clierr := runAppPortForward(&appPortForwardConfig{
+			KymaConfig: fixKymaConfig(fixKubeClient()),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(bytes.NewBuffer([]byte{})))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app default/my-app not found")
+	})
+
+	t.Run("app without ports", func(t *testing.T) {
+		clierr := runAppPortForward(&appPortForwardConfig{
+			KymaConfig: fixKymaConfig(fixKubeClient(fixAppDeployment("my-app", "default"))),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(bytes.NewBuffer([]byte{})))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the default/my-app app has no ports")
+	})
+}
+
+func Test_parsePortMapping(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    portMapping
+		wantErr string
+	}{
+		{value: "", want: portMapping{}},
+		{value: "8080", want: portMapping{remote: "8080"}},
+		{value: "9000:8080", want: portMapping{local: ptr.To(9000), remote: "8080"}},
+		{value: ":metrics", want: portMapping{local: ptr.To(0), remote: "metrics"}},
+		{value: "9000:", wantErr: "missing remote port"},
+		{value: "70000:8080", wantErr: "invalid local port number '70000', should be between 1 and 65535"},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePortMapping(tt.value)
+		if tt.wantErr != "" {
+			require.EqualError(t, err, tt.wantErr, tt.value)
+			continue
+		}
+		require.NoError(t, err, tt.value)
+		require.Equal(t, tt.want, got, tt.value)
+	}
+}
+
+func Test_resolveAppPort(t *testing.T) {
+	deployment := fixAppDeployment("my-app", "default")
+	deployment.Spec.Template.Spec.Containers[0].Ports = []corev1.ContainerPort{
+		{Name: "grpc", ContainerPort: 9000},
+		{Name: "http", ContainerPort: 8080},
+		{Name: "metrics", ContainerPort: 9090},
+	}
+
+	tests := []struct {
+		remote  string
+		want    int32
+		wantErr string
+	}{
+		{remote: "", want: 8080},
+		{remote: "metrics", want: 9090},
+		{remote: "3000", want: 3000},
+		{remote: "70000", wantErr: "invalid remote port number '70000'"},
+		{remote: "debug", wantErr: "the default/my-app app has no port named 'debug'"},
+	}
+
+	for _, tt := range tests {
+		got, clierr := resolveAppPort(deployment, tt.remote)
+		if tt.wantErr != "" {
+			require.NotNil(t, clierr, tt.remote)
+			require.Contains(t, clierr.String(), tt.wantErr, tt.remote)
+			continue
+		}
+		require.Nil(t, clierr, tt.remote)
+		require.Equal(t, tt.want, got, tt.remote)
+	}
+
+	t.Run("use the first port if there is no http port", func(t *testing.T) {
+		deployment := fixAppDeployment("my-app", "default")
+		deployment.Spec.Template.Spec.Containers[0].Ports = []corev1.ContainerPort{
+			{Name: "grpc", ContainerPort: 9000},
+		}
+
+		got, clierr := resolveAppPort(deployment, "")
+		require.Nil(t, clierr)
+		require.Equal(t, int32(9000), got)
+	})
+}
@@ -2,8 +2,11 @@ package app
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
@@ -19,6 +22,11 @@ import (
 	"github.com/kyma-project/cli.v3/internal/pack"
 	"github.com/kyma-project/cli.v3/internal/registry"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
 )
 
 var buildTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`)
@@ -36,6 +44,19 @@ type appPushConfig struct {
 	dockerfileArgs             types.Map
 	packAppPath                string
 	containerPort              types.NullableInt64
+	ports                      types.PortArray
+	exposePort                 string
+	exposeHost                 string
+	exposeMethods              []string
+	exposePaths                []string
+	jwtIssuer                  string
+	jwtJWKSURI                 string
+	extAuthAuthorizers         []string
+	corsAllowOrigins           []string
+	corsAllowMethods           []string
+	corsAllowHeaders           []string
+	corsAllowCredentials       bool
+	corsMaxAge                 time.Duration
 	istioInject                types.NullableBool
 	envs                       types.EnvMap
 	fileEnvs                   types.SourcedEnvArray
@@ -47,13 +68,27 @@ type appPushConfig struct {
 	mountServiceBindingSecrets types.ServiceBindingSecretArray
 	quiet                      bool
 	insecure                   bool
+	watch                      bool
+	timeout                    time.Duration
+	replicas                   types.NullableInt64
+	cpuRequest                 string
+	cpuLimit                   string
+	memoryRequest              string
+	memoryLimit                string
+	livenessProbePath          string
+	readinessProbePath         string
+	autoscaleMinReplicas       types.NullableInt64
+	autoscaleMaxReplicas       types.NullableInt64
+	autoscaleCPUUtilization    int32
+
+	// resources parsed from the cpu and memory flags
+	resources resources.ContainerResources
 }
 
 func NewAppPushCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
-	config := appPushConfig{
-		KymaConfig: kymaConfig,
-		envs:       types.EnvMap{Map: &types.Map{Values: map[string]interface{}{}}},
-	}
+	config := newAppPushConfig(kymaConfig)
+	output := appPushOutput{}
+	manifestPath := ""
 
 	cmd := &cobra.Command{
 		Use:   "push [flags]",
@@ -73,9 +108,41 @@ func NewAppPushCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
   # Push an application based on a pre-built image:
   kyma app push --name my-app --image eu.gcr.io/my-project/my-app:latest
 
+  ## Push all applications defined in the manifest file:
+  #  Flags provided in the command line override values from the file for all applications.
+  #  Use the --name flag to push only one application from the file that defines many applications.
+  kyma app push -f kyma-app.yaml
+  kyma app push -f kyma-app.yaml --name my-app --namespace my-namespace
+
   # Push an application and expose it using an APIRule:
   kyma app push --name my-app --code-path . --container-port 8080 --expose --istio-inject=true
 
+  # Push an application with HTTP and gRPC ports and expose the gRPC port using an APIRule:
+  kyma app push --name my-app --image eu.gcr.io/my-project/my-app:latest \
+    --port http=8080 --port grpc=9000 --expose --expose-port grpc
+
+  # Push an application and expose its API under a custom subdomain for requests with JWTs:
+  kyma app push --name my-app --image eu.gcr.io/my-project/my-app:latest --container-port 8080 --expose \
+    --expose-host orders --expose-path "/orders/{**}" \
+    --jwt-issuer https://my-issuer.com --jwt-jwks-uri https://my-issuer.com/oauth2/certs
+
+  # Push an application with resources, probes, and the HorizontalPodAutoscaler:
+  kyma app push --name my-app --image eu.gcr.io/my-project/my-app:latest --container-port 8080 \
+    --cpu-request 100m --cpu-limit 500m --memory-request 128Mi --memory-limit 256Mi \
+    --liveness-probe-path /healthz --readiness-probe-path /ready \
+    --autoscale-min-replicas 2 --autoscale-max-replicas 5 --autoscale-cpu-utilization 70
+
+  ## Generate resources of an application instead of applying them:
+  #  The dry run prints exactly the resources applied by the push command, so it requires a pre-built image.
+  kyma app push --name my-app --image eu.gcr.io/my-project/my-app:latest --container-port 8080 --expose --dry-run
+  kyma app push --name my-app --image eu.gcr.io/my-project/my-app:latest --dry-run -o json
+
+  # Write resources of all applications from the manifest file to separate files with the kustomization.yaml file:
+  kyma app push -f kyma-app.yaml --dry-run --output-dir ./deploy --kustomize
+
+  # Push an application and wait up to 2 minutes for its rollout:
+  kyma app push --name my-app --image eu.gcr.io/my-project/my-app:latest --watch --timeout 2m
+
   ## Push an application and set environment variables:
   #  This flag overrides existing environment variables with the same name from other sources (file, ConfigMap, Secret).
   #  To set an environment variable, use the format 'NAME=VALUE' or 'name=<NAME>,value=<VALUE>'.
@@ -112,56 +179,141 @@ func NewAppPushCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
     --mount-service-binding-secret my-service-binding-secret`,
 
 		PreRun: func(cmd *cobra.Command, args []string) {
+			clierror.Check(flags.Validate(cmd.Flags(), appPushOutputFlagRules...))
+			if manifestPath != "" {
+				// flags are validated for every app defined in the manifest
+				return
+			}
+
 			clierror.Check(config.complete())
-			clierror.Check(flags.Validate(cmd.Flags(),
-				flags.MarkRequired("name"),
-				flags.MarkExactlyOneRequired("image", "dockerfile", "code-path"),
-				flags.MarkExclusive("dockerfile-context", "image", "code-path"),
-				flags.MarkExclusive("dockerfile-build-arg", "image", "code-path"),
-				flags.MarkExclusive("build-tag", "image"),
-				flags.MarkPrerequisites("expose", "container-port"),
-				flags.MarkPrerequisites("image-pull-secret", "image"),
-			))
+			clierror.Check(flags.Validate(cmd.Flags(), appPushFlagRules...))
 			clierror.Check(config.validate())
 		},
-		Run: func(_ *cobra.Command, _ []string) {
+		Run: func(cmd *cobra.Command, _ []string) {
+			if manifestPath != "" {
+				clierror.Check(runAppPushManifest(kymaConfig, cmd.Flags(), manifestPath, &output))
+				return
+			}
+
+			if output.dryRun {
+				clierror.Check(runAppPushDryRun(&output, out.Default, &config))
+				return
+			}
+
 			clierror.Check(runAppPush(&config))
 		},
 	}
 
+	config.addFlags(cmd.Flags())
+	output.addFlags(cmd.Flags())
+	cmd.Flags().StringVarP(&manifestPath, "file", "f", "", "Path to the app manifest file with one or many apps (flags override values from the file)")
+	recordFlagValues(cmd.Flags())
+
+	return cmd
+}
+
+var appPushFlagRules = append([]flags.Rule{
+	flags.MarkRequired("name"),
+	flags.MarkExactlyOneRequired("image", "dockerfile", "code-path"),
+}, appDeploymentFlagRules...)
+
+// rules of flags configuring the app resources, shared by the push and dev commands
+var appDeploymentFlagRules = []flags.Rule{
+	flags.MarkExclusive("dockerfile-context", "image", "code-path"),
+	flags.MarkExclusive("dockerfile-build-arg", "image", "code-path"),
+	flags.MarkExclusive("build-tag", "image"),
+	flags.MarkExclusive("container-port", "port"),
+	flags.MarkOneOfPrerequisites("expose", "container-port", "port"),
+	flags.MarkPrerequisites("expose-port", "expose"),
+	flags.MarkPrerequisites("expose-host", "expose"),
+	flags.MarkPrerequisites("expose-method", "expose"),
+	flags.MarkPrerequisites("expose-path", "expose"),
+	flags.MarkPrerequisites("jwt-issuer", "expose"),
+	flags.MarkRequiredTogether("jwt-issuer", "jwt-jwks-uri"),
+	flags.MarkPrerequisites("ext-auth-authorizer", "expose"),
+	flags.MarkMutuallyExclusive("jwt-issuer", "ext-auth-authorizer"),
+	flags.MarkPrerequisites("cors-allow-origin", "expose"),
+	flags.MarkPrerequisites("cors-allow-method", "cors-allow-origin"),
+	flags.MarkPrerequisites("cors-allow-header", "cors-allow-origin"),
+	flags.MarkPrerequisites("cors-allow-credentials", "cors-allow-origin"),
+	flags.MarkPrerequisites("cors-max-age", "cors-allow-origin"),
+	flags.MarkPrerequisites("image-pull-secret", "image"),
+	flags.MarkPrerequisites("timeout", "watch"),
+	flags.MarkOneOfPrerequisites("liveness-probe-path", "container-port", "port"),
+	flags.MarkOneOfPrerequisites("readiness-probe-path", "container-port", "port"),
+	flags.MarkExclusive("replicas", "autoscale-max-replicas"),
+	flags.MarkPrerequisites("autoscale-min-replicas", "autoscale-max-replicas"),
+	flags.MarkPrerequisites("autoscale-cpu-utilization", "autoscale-max-replicas"),
+}
+
+func newAppPushConfig(kymaConfig *cmdcommon.KymaConfig) appPushConfig {
+	return appPushConfig{
+		KymaConfig: kymaConfig,
+		envs:       types.EnvMap{Map: &types.Map{Values: map[string]interface{}{}}},
+	}
+}
+
+func (apc *appPushConfig) addFlags(flagSet *pflag.FlagSet) {
 	// common flags
-	cmd.Flags().StringVar(&config.name, "name", "", "Name of the app")
-	cmd.Flags().BoolVar(&config.insecure, "insecure", false, "Disables SecurityContext configuration for the app deployment")
-	cmd.Flags().BoolVarP(&config.quiet, "quiet", "q", false, "Suppresses non-essential output (prints only the URL of the pushed app, if exposed)")
-	cmd.Flags().Var(&config.envs, "env", "Environment variables for the app in format NAME=VALUE")
-	cmd.Flags().Var(&config.fileEnvs, "env-from-file", "Environment variables for the app loaded from a file in format ENV_NAME=FILE_PATH:FILE_KEY for a single key or FILE_PATH[:ENVS_PREFIX] to fetch all keys")
-	cmd.Flags().Var(&config.configmapEnvs, "env-from-configmap", "Environment variables for the app loaded from a ConfigMap in format ENV_NAME=RESOURCE:RESOURCE_KEY for a single key or RESOURCE[:ENVS_PREFIX] to fetch all keys")
-	cmd.Flags().Var(&config.secretEnvs, "env-from-secret", "Environment variables for the app loaded from a Secret in format ENV_NAME=RESOURCE:RESOURCE_KEY for a single key or RESOURCE[:ENVS_PREFIX] to fetch all keys")
+	flagSet.StringVar(&apc.name, "name", "", "Name of the app")
+	flagSet.BoolVar(&apc.insecure, "insecure", false, "Disables SecurityContext configuration for the app deployment")
+	flagSet.BoolVarP(&apc.quiet, "quiet", "q", false, "Suppresses non-essential output (prints only the URL of the pushed app, if exposed)")
+	flagSet.BoolVarP(&apc.watch, "watch", "w", false, "Waits for the app rollout, prints its progress, and diagnoses failing pods")
+	flagSet.DurationVar(&apc.timeout, "timeout", 5*time.Minute, "Maximum time to wait for the app rollout (used with --watch)")
+	flagSet.Var(&apc.envs, "env", "Environment variables for the app in format NAME=VALUE")
+	flagSet.Var(&apc.fileEnvs, "env-from-file", "Environment variables for the app loaded from a file in format ENV_NAME=FILE_PATH:FILE_KEY for a single key or FILE_PATH[:ENVS_PREFIX] to fetch all keys")
+	flagSet.Var(&apc.configmapEnvs, "env-from-configmap", "Environment variables for the app loaded from a ConfigMap in format ENV_NAME=RESOURCE:RESOURCE_KEY for a single key or RESOURCE[:ENVS_PREFIX] to fetch all keys")
+	flagSet.Var(&apc.secretEnvs, "env-from-secret", "Environment variables for the app loaded from a Secret in format ENV_NAME=RESOURCE:RESOURCE_KEY for a single key or RESOURCE[:ENVS_PREFIX] to fetch all keys")
 
 	// image flags
-	cmd.Flags().StringVar(&config.image, "image", "", "Name of the image to deploy")
-	cmd.Flags().StringVar(&config.imagePullSecretName, "image-pull-secret", "", "Name of the Kubernetes Secret with credentials to pull the image")
-	cmd.Flags().StringVar(&config.buildTag, "build-tag", "", "Custom tag for the built image (e.g. a Git commit SHA). Applies only to --code-path and --dockerfile builds.")
+	flagSet.StringVar(&apc.image, "image", "", "Name of the image to deploy")
+	flagSet.StringVar(&apc.imagePullSecretName, "image-pull-secret", "", "Name of the Kubernetes Secret with credentials to pull the image")
+	flagSet.StringVar(&apc.buildTag, "build-tag", "", "Custom tag for the built image (e.g. a Git commit SHA). Applies only to --code-path and --dockerfile builds.")
 
 	// dockerfile flags
-	cmd.Flags().StringVar(&config.dockerfilePath, "dockerfile", "", "Path to the Dockerfile")
-	cmd.Flags().StringVar(&config.dockerfileSrcContext, "dockerfile-context", "", "Context path for building Dockerfile (defaults to the current working directory)")
-	cmd.Flags().Var(&config.dockerfileArgs, "dockerfile-build-arg", "Variables used while building an application from Dockerfile as args")
+	flagSet.StringVar(&apc.dockerfilePath, "dockerfile", "", "Path to the Dockerfile")
+	flagSet.StringVar(&apc.dockerfileSrcContext, "dockerfile-context", "", "Context path for building Dockerfile (defaults to the current working directory)")
+	flagSet.Var(&apc.dockerfileArgs, "dockerfile-build-arg", "Variables used while building an application from Dockerfile as args")
 
 	// pack flags
-	cmd.Flags().StringVar(&config.packAppPath, "code-path", "", "Path to the application source code directory")
+	flagSet.StringVar(&apc.packAppPath, "code-path", "", "Path to the application source code directory")
 
 	// k8s flags
-	cmd.Flags().StringVarP(&config.namespace, "namespace", "n", "default", "Namespace where the app is deployed")
-	cmd.Flags().Var(&config.containerPort, "container-port", "Port on which the application is exposed")
-	istioInjectFlag := cmd.Flags().VarPF(&config.istioInject, "istio-inject", "", "Enables Istio for the app")
+	flagSet.StringVarP(&apc.namespace, "namespace", "n", "default", "Namespace where the app is deployed")
+	flagSet.Var(&apc.containerPort, "container-port", "Port on which the application is exposed (shorthand for --port http=<PORT>)")
+	flagSet.Var(&apc.ports, "port", "Named port of the app in format NAME=PORT (e.g. http=8080 or grpc=9000), the Istio protocol is derived from the name and defaults to http")
+	istioInjectFlag := flagSet.VarPF(&apc.istioInject, "istio-inject", "", "Enables Istio for the app")
 	istioInjectFlag.NoOptDefVal = "true" // default value when flag is provided without value
-	cmd.Flags().BoolVar(&config.expose, "expose", false, "Creates an APIRule for the app")
-	cmd.Flags().Var(&config.mountSecrets, "mount-secret", "Mounts Secret content. Format: 'name=secret,path=/app/config,key=key,ro=true' or shorthand 'secret:key=/app/config:ro'. Path traversal (..) is prohibited.")
-	cmd.Flags().Var(&config.mountConfigmaps, "mount-config", "Mounts ConfigMap content. Format: 'name=configmap,path=/app/config,key=key,ro=false' or shorthand 'configmap:key=/app/config'. Path traversal (..) is prohibited.")
-	cmd.Flags().Var(&config.mountServiceBindingSecrets, "mount-service-binding-secret", "Mounts Secret as service binding at /bindings/secret-<NAME> (readOnly)")
-
-	return cmd
+	flagSet.BoolVar(&apc.expose, "expose", false, "Creates an APIRule for the app")
+	flagSet.StringVar(&apc.exposePort, "expose-port", "", "Name of the port exposed by the APIRule (defaults to the port named http or the first port using the http protocol)")
+
+	// APIRule flags
+	flagSet.StringVar(&apc.exposeHost, "expose-host", "", "Host of the APIRule, either a subdomain of the cluster domain or a fully qualified domain name (defaults to the app name)")
+	flagSet.StringSliceVar(&apc.exposeMethods, "expose-method", nil, fmt.Sprintf("HTTP methods allowed by the APIRule (defaults to %s)", strings.Join(resources.DefaultAPIRuleMethods, ",")))
+	flagSet.StringSliceVar(&apc.exposePaths, "expose-path", nil, fmt.Sprintf("Paths exposed by the APIRule, e.g. /orders/{**} (defaults to %s)", resources.DefaultAPIRulePath))
+	flagSet.StringVar(&apc.jwtIssuer, "jwt-issuer", "", "Issuer of JWTs required to access the app (the APIRule doesn't require authentication by default)")
+	flagSet.StringVar(&apc.jwtJWKSURI, "jwt-jwks-uri", "", "URI of the JSON Web Key Set used to verify JWTs of the issuer")
+	flagSet.StringSliceVar(&apc.extAuthAuthorizers, "ext-auth-authorizer", nil, "Names of the external authorizers configured in Istio that authorize requests to the app")
+	flagSet.StringSliceVar(&apc.corsAllowOrigins, "cors-allow-origin", nil, "Origins allowed to access the app in the CORS policy of the APIRule, use * to allow all origins")
+	flagSet.StringSliceVar(&apc.corsAllowMethods, "cors-allow-method", nil, "HTTP methods allowed in the CORS policy")
+	flagSet.StringSliceVar(&apc.corsAllowHeaders, "cors-allow-header", nil, "HTTP headers allowed in the CORS policy")
+	flagSet.BoolVar(&apc.corsAllowCredentials, "cors-allow-credentials", false, "Allows requests with credentials in the CORS policy")
+	flagSet.DurationVar(&apc.corsMaxAge, "cors-max-age", 0, "Time for which results of preflight requests can be cached")
+	flagSet.Var(&apc.mountSecrets, "mount-secret", "Mounts Secret content. Format: 'name=secret,path=/app/config,key=key,ro=true' or shorthand 'secret:key=/app/config:ro'. Path traversal (..) is prohibited.")
+	flagSet.Var(&apc.mountConfigmaps, "mount-config", "Mounts ConfigMap content. Format: 'name=configmap,path=/app/config,key=key,ro=false' or shorthand 'configmap:key=/app/config'. Path traversal (..) is prohibited.")
+	flagSet.Var(&apc.mountServiceBindingSecrets, "mount-service-binding-secret", "Mounts Secret as service binding at /bindings/secret-<NAME> (readOnly)")
+	flagSet.Var(&apc.replicas, "replicas", "Number of the app pods (defaults to 1)")
+	flagSet.StringVar(&apc.cpuRequest, "cpu-request", "", fmt.Sprintf("CPU request of the app container (defaults to %s)", resources.DefaultCPURequest))
+	flagSet.StringVar(&apc.cpuLimit, "cpu-limit", "", fmt.Sprintf("CPU limit of the app container (defaults to %s)", resources.DefaultCPULimit))
+	flagSet.StringVar(&apc.memoryRequest, "memory-request", "", fmt.Sprintf("Memory request of the app container (defaults to %s)", resources.DefaultMemoryRequest))
+	flagSet.StringVar(&apc.memoryLimit, "memory-limit", "", fmt.Sprintf("Memory limit of the app container (defaults to %s)", resources.DefaultMemoryLimit))
+	flagSet.StringVar(&apc.livenessProbePath, "liveness-probe-path", "", "Path of the HTTP liveness probe sent to the container port")
+	flagSet.StringVar(&apc.readinessProbePath, "readiness-probe-path", "", "Path of the HTTP readiness probe sent to the container port")
+
+	// autoscaling flags
+	flagSet.Var(&apc.autoscaleMinReplicas, "autoscale-min-replicas", "Minimum number of the app pods managed by the HorizontalPodAutoscaler (defaults to 1)")
+	flagSet.Var(&apc.autoscaleMaxReplicas, "autoscale-max-replicas", "Maximum number of the app pods, creates the HorizontalPodAutoscaler for the app")
+	flagSet.Int32Var(&apc.autoscaleCPUUtilization, "autoscale-cpu-utilization", 80, "Target average CPU utilization of the app pods in percents of the CPU request")
 }
 
 func (apc *appPushConfig) complete() clierror.Error {
@@ -213,97 +365,374 @@ func (apc *appPushConfig) validate() clierror.Error {
 		}
 	}
 
-	return nil
+	clierr := apc.validateScaling()
+	if clierr != nil {
+		return clierr
+	}
+
+	clierr = apc.validatePorts()
+	if clierr != nil {
+		return clierr
+	}
+
+	for _, probe := range []struct{ flag, path string }{
+		{"liveness-probe-path", apc.livenessProbePath},
+		{"readiness-probe-path", apc.readinessProbePath},
+	} {
+		if probe.path != "" && !strings.HasPrefix(probe.path, "/") {
+			return clierror.New(fmt.Sprintf("invalid value '%s' of the --%s flag", probe.path, probe.flag), "probe path must start with '/'")
+		}
+		if probe.path != "" && resources.PrimaryHTTPPort(apc.appPorts()) == nil {
+			return clierror.New(fmt.Sprintf("the --%s flag requires a port using the http protocol", probe.flag),
+				"use the --port flag to define the http port, for example --port http=8080")
+		}
+	}
+
+	return apc.parseResources()
 }
 
-func runAppPush(cfg *appPushConfig) clierror.Error {
-	if cfg.quiet {
-		out.DisableMsg()
+func (apc *appPushConfig) validatePorts() clierror.Error {
+	if apc.containerPort.Value != nil && (*apc.containerPort.Value < 1 || *apc.containerPort.Value > 65535) {
+		return clierror.New("invalid value of the --container-port flag", "port must be between 1 and 65535")
 	}
 
-	image := cfg.image
-	imagePullSecret := cfg.imagePullSecretName
+	if !apc.expose {
+		return nil
+	}
 
-	client, clierr := cfg.GetKubeClientWithClierr()
+	clierr := apc.validateAPIRule()
 	if clierr != nil {
 		return clierr
 	}
 
-	if cfg.dockerfilePath != "" || cfg.packAppPath != "" {
-		registryConfig, cliErr := registry.GetInternalConfig(cfg.Ctx, client)
-		if cliErr != nil {
-			return cliErr
+	if apc.exposedPort() == nil {
+		if apc.exposePort != "" {
+			portNames := []string{}
+			for _, port := range apc.appPorts() {
+				portNames = append(portNames, port.Name)
+			}
+			return clierror.New(fmt.Sprintf("invalid value '%s' of the --expose-port flag", apc.exposePort),
+				fmt.Sprintf("use the name of one of the app ports: %s", strings.Join(portNames, ", ")))
 		}
 
-		pushedImage, clierr := buildAndImportImage(client, cfg, registryConfig)
-		if clierr != nil {
-			return clierr
+		return clierror.New("the app has no port using the http protocol to expose",
+			"use the --expose-port flag to choose the exposed port")
+	}
+
+	return nil
+}
+
+func (apc *appPushConfig) validateAPIRule() clierror.Error {
+	if apc.exposeHost != "" {
+		hostErrs := validation.IsDNS1123Subdomain(apc.exposeHost)
+		if !strings.Contains(apc.exposeHost, ".") {
+			hostErrs = validation.IsDNS1123Label(apc.exposeHost)
+		}
+		if len(hostErrs) != 0 {
+			return clierror.New(fmt.Sprintf("invalid value '%s' of the --expose-host flag", apc.exposeHost), hostErrs...)
 		}
-		image = fmt.Sprintf("%s/%s", registryConfig.SecretData.PullRegAddr, pushedImage)
-		imagePullSecret = registryConfig.SecretName
 	}
 
-	out.Msgfln("\nApplying Deployment %s/%s", cfg.namespace, cfg.name)
+	for _, method := range append(slices.Clone(apc.exposeMethods), apc.corsAllowMethods...) {
+		if !slices.Contains(resources.APIRuleMethods, strings.ToUpper(method)) {
+			return clierror.New(fmt.Sprintf("invalid HTTP method '%s'", method),
+				fmt.Sprintf("use one of the methods: %s", strings.Join(resources.APIRuleMethods, ", ")))
+		}
+	}
+
+	for _, path := range apc.exposePaths {
+		if !strings.HasPrefix(path, "/") {
+			return clierror.New(fmt.Sprintf("invalid value '%s' of the --expose-path flag", path), "path must start with '/'")
+		}
+	}
+
+	if apc.jwtJWKSURI != "" {
+		jwksURI, err := url.Parse(apc.jwtJWKSURI)
+		if err != nil || jwksURI.Scheme != "https" || jwksURI.Host == "" {
+			return clierror.New(fmt.Sprintf("invalid value '%s' of the --jwt-jwks-uri flag", apc.jwtJWKSURI),
+				"use the HTTPS URL of the JSON Web Key Set, for example https://my-issuer.com/oauth2/certs")
+		}
+	}
+
+	return nil
+}
+
+// apiRuleOpts returns the APIRule configuration built from the expose flags
+func (apc *appPushConfig) apiRuleOpts() resources.CreateAPIRuleOpts {
+	opts := resources.CreateAPIRuleOpts{
+		Name:           apc.name,
+		Namespace:      apc.namespace,
+		Host:           apc.exposeHost,
+		Port:           uint32(apc.exposedPort().Port),
+		Methods:        apc.exposeMethods,
+		Paths:          apc.exposePaths,
+		ExtAuthorizers: apc.extAuthAuthorizers,
+	}
+	if opts.Host == "" {
+		opts.Host = apc.name
+	}
+
+	if apc.jwtIssuer != "" {
+		opts.JWT = &resources.APIRuleJWT{
+			Issuer:  apc.jwtIssuer,
+			JWKSURI: apc.jwtJWKSURI,
+		}
+	}
 
-	clierr = createDeployment(cfg, client, image, imagePullSecret)
+	if len(apc.corsAllowOrigins) != 0 {
+		opts.CORS = &resources.APIRuleCORS{
+			AllowOrigins:     apc.corsAllowOrigins,
+			AllowMethods:     apc.corsAllowMethods,
+			AllowHeaders:     apc.corsAllowHeaders,
+			AllowCredentials: apc.corsAllowCredentials,
+			MaxAge:           apc.corsMaxAge,
+		}
+	}
+
+	return opts
+}
+
+// appPorts returns ports from the --port flag or the http port from the --container-port flag
+func (apc *appPushConfig) appPorts() []types.PortSpec {
+	if apc.containerPort.Value != nil {
+		return []types.PortSpec{{Name: "http", Port: int32(*apc.containerPort.Value)}}
+	}
+
+	return apc.ports.Ports
+}
+
+// exposedPort returns the port targeted by the APIRule or nil if there is no such port
+func (apc *appPushConfig) exposedPort() *types.PortSpec {
+	ports := apc.appPorts()
+	if apc.exposePort == "" {
+		return resources.PrimaryHTTPPort(ports)
+	}
+
+	for i := range ports {
+		if ports[i].Name == apc.exposePort {
+			return &ports[i]
+		}
+	}
+
+	return nil
+}
+
+func (apc *appPushConfig) validateScaling() clierror.Error {
+	if apc.replicas.Value != nil && *apc.replicas.Value < 0 {
+		return clierror.New("invalid value of the --replicas flag", "number of replicas can't be negative")
+	}
+
+	if apc.autoscaleMaxReplicas.Value == nil {
+		return nil
+	}
+
+	minReplicas := apc.autoscaleMinReplicasValue()
+	if minReplicas < 1 {
+		return clierror.New("invalid value of the --autoscale-min-replicas flag", "minimum number of replicas must be at least 1")
+	}
+	if *apc.autoscaleMaxReplicas.Value < int64(minReplicas) {
+		return clierror.New("invalid value of the --autoscale-max-replicas flag",
+			"maximum number of replicas can't be lower than the minimum number of replicas")
+	}
+	if apc.autoscaleCPUUtilization < 1 {
+		return clierror.New("invalid value of the --autoscale-cpu-utilization flag", "target CPU utilization must be at least 1")
+	}
+
+	return nil
+}
+
+// hpaOpts returns the HorizontalPodAutoscaler configuration built from the autoscale flags
+func (apc *appPushConfig) hpaOpts() resources.CreateHPAOpts {
+	return resources.CreateHPAOpts{
+		Name:           apc.name,
+		Namespace:      apc.namespace,
+		MinReplicas:    apc.autoscaleMinReplicasValue(),
+		MaxReplicas:    int32(*apc.autoscaleMaxReplicas.Value),
+		CPUUtilization: apc.autoscaleCPUUtilization,
+	}
+}
+
+func (apc *appPushConfig) autoscaleMinReplicasValue() int32 {
+	if apc.autoscaleMinReplicas.Value == nil {
+		return 1
+	}
+
+	return int32(*apc.autoscaleMinReplicas.Value)
+}
+
+// parseResources parses cpu and memory flags and makes sure requests don't exceed limits
+func (apc *appPushConfig) parseResources() clierror.Error {
+	quantities := []struct {
+		flag  string
+		value string
+		dest  **resource.Quantity
+	}{
+		{"cpu-request", apc.cpuRequest, &apc.resources.CPURequest},
+		{"cpu-limit", apc.cpuLimit, &apc.resources.CPULimit},
+		{"memory-request", apc.memoryRequest, &apc.resources.MemoryRequest},
+		{"memory-limit", apc.memoryLimit, &apc.resources.MemoryLimit},
+	}
+
+	for _, quantity := range quantities {
+		if quantity.value == "" {
+			continue
+		}
+
+		parsed, err := resource.ParseQuantity(quantity.value)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("invalid value '%s' of the --%s flag", quantity.value, quantity.flag),
+				"use the Kubernetes quantity format, for example 100m or 0.5 for CPU and 128Mi or 1Gi for memory"))
+		}
+		*quantity.dest = &parsed
+	}
+
+	requirements := apc.resources.ResourceRequirements()
+	for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		request := requirements.Requests[resourceName]
+		limit := requirements.Limits[resourceName]
+		if request.Cmp(limit) > 0 {
+			return clierror.New(
+				fmt.Sprintf("%s request %s exceeds %s limit %s", resourceName, request.String(), resourceName, limit.String()),
+				fmt.Sprintf("use the --%s-limit flag to set the limit greater than or equal to the request", resourceName),
+			)
+		}
+	}
+
+	return nil
+}
+
+func runAppPush(cfg *appPushConfig) clierror.Error {
+	if cfg.quiet {
+		out.DisableMsg()
+	}
+
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	imagePullSecret, clierr := applyAppDeployment(cfg, client)
 	if clierr != nil {
 		return clierr
 	}
 
-	if cfg.containerPort.Value != nil {
+	if ports := cfg.appPorts(); len(ports) != 0 {
 		out.Msgfln("\nApplying Service %s/%s", cfg.namespace, cfg.name)
-		err := resources.ApplyService(cfg.Ctx, client, cfg.name, cfg.namespace, int32(*cfg.containerPort.Value))
+		err := resources.ApplyService(cfg.Ctx, client, cfg.name, cfg.namespace, ports)
 		if err != nil {
 			return clierror.Wrap(err, clierror.New("failed to apply Service"))
 		}
 	}
 
+	if cfg.autoscaleMaxReplicas.Value != nil {
+		out.Msgfln("\nApplying HorizontalPodAutoscaler %s/%s", cfg.namespace, cfg.name)
+		err := resources.ApplyHPA(cfg.Ctx, client.RootlessDynamic(), cfg.hpaOpts())
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to apply HorizontalPodAutoscaler"))
+		}
+	}
+
+	if cfg.watch {
+		clierr = waitForAppRollout(cfg, client, imagePullSecret)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
 	if cfg.expose {
 		out.Msgfln("\nCreating API Rule %s/%s", cfg.namespace, cfg.name)
-		url := fmt.Sprintf("%s.<CLUSTER_DOMAIN>", cfg.name)
+		apiRuleOpts := cfg.apiRuleOpts()
 
-		err := resources.CreateAPIRule(cfg.Ctx, client.RootlessDynamic(), cfg.name, cfg.namespace, cfg.name, uint32(*cfg.containerPort.Value))
+		err := resources.CreateAPIRule(cfg.Ctx, client.RootlessDynamic(), apiRuleOpts)
 		if err != nil {
 			return clierror.Wrap(err, clierror.New("failed to create the APIRule resource", "Make sure the API Gateway module is installed", "Make sure APIRule CRD is available in the v2 version"))
 		}
 
-		// try to get domain from resulting virtual service
-		// Check if the user can watch virtualservices
-		authRes, authErr := resources.CreateSelfSubjectAccessReview(cfg.Ctx, client, "watch", "virtualservices", cfg.namespace, "networking.istio.io")
-
-		if authErr != nil {
-			return clierror.Wrap(authErr, clierror.New("failed to check permissions to get VirtualServices"))
-		}
-		if authRes.Status.Allowed {
-			url, clierr = client.Istio().GetHostFromVirtualServiceByApiruleName(cfg.Ctx, cfg.name, cfg.namespace)
-			if clierr != nil {
-				return clierror.WrapE(clierr, clierror.New("failed to get host address of APIRule's VirtualService"))
-			}
+		appURL, clierr := getAPIRuleURL(cfg.Ctx, client, cfg.name, cfg.namespace, apiRuleOpts.Host)
+		if clierr != nil {
+			return clierr
 		}
 
 		out.Msgfln("\nThe %s app is available under the", cfg.name)
 		// print the URL regardless if in quiet mode
-		out.Prio(url)
+		out.Prio(appURL)
 
 	}
 
 	return nil
 }
 
+// applyAppDeployment builds the app image if needed and applies the Deployment, returns the name of the image pull Secret
+func applyAppDeployment(cfg *appPushConfig, client kube.Client) (string, clierror.Error) {
+	image := cfg.image
+	imagePullSecret := cfg.imagePullSecretName
+
+	if cfg.dockerfilePath != "" || cfg.packAppPath != "" {
+		registryConfig, cliErr := registry.GetInternalConfig(cfg.Ctx, client)
+		if cliErr != nil {
+			return "", cliErr
+		}
+
+		pushedImage, clierr := buildAndImportImage(client, cfg, registryConfig)
+		if clierr != nil {
+			return "", clierr
+		}
+		image = fmt.Sprintf("%s/%s", registryConfig.SecretData.PullRegAddr, pushedImage)
+		imagePullSecret = registryConfig.SecretName
+	}
+
+	out.Msgfln("\nApplying Deployment %s/%s", cfg.namespace, cfg.name)
+
+	clierr := createDeployment(cfg, client, image, imagePullSecret)
+	if clierr != nil {
+		return "", clierr
+	}
+
+	return imagePullSecret, nil
+}
+
+func waitForAppRollout(cfg *appPushConfig, client kube.Client, imagePullSecret string) clierror.Error {
+	out.Msgfln("\nWaiting for Deployment %s/%s rollout", cfg.namespace, cfg.name)
+	watcher := &rolloutWatcher{
+		client:          client.Static(),
+		printer:         out.Default,
+		name:            cfg.name,
+		namespace:       cfg.namespace,
+		imagePullSecret: imagePullSecret,
+	}
+
+	return watcher.wait(cfg.Ctx, cfg.timeout)
+}
+
 func createDeployment(cfg *appPushConfig, client kube.Client, image, imagePullSecret string) clierror.Error {
+	opts, clierr := deploymentOpts(cfg, client, image, imagePullSecret)
+	if clierr != nil {
+		return clierr
+	}
+
+	err := resources.ApplyDeployment(cfg.Ctx, client, opts)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to apply Deployment"))
+	}
+
+	return nil
+}
+
+// deploymentOpts returns the Deployment configuration with envs loaded from all sources
+func deploymentOpts(cfg *appPushConfig, client kube.Client, image, imagePullSecret string) (resources.CreateDeploymentOpts, clierror.Error) {
 	configmapEnvs, err := envs.BuildFromConfigmap(cfg.Ctx, client, cfg.namespace, cfg.configmapEnvs)
 	if err != nil {
-		return clierror.Wrap(err, clierror.New("failed to build envs from ConfigMap"))
+		return resources.CreateDeploymentOpts{}, clierror.Wrap(err, clierror.New("failed to build envs from ConfigMap"))
 	}
 
 	secretEnvs, err := envs.BuildFromSecret(cfg.Ctx, client, cfg.namespace, cfg.secretEnvs)
 	if err != nil {
-		return clierror.Wrap(err, clierror.New("failed to build envs from Secret"))
+		return resources.CreateDeploymentOpts{}, clierror.Wrap(err, clierror.New("failed to build envs from Secret"))
 	}
 
 	fileEnvs, err := envs.BuildFromFile(cfg.fileEnvs)
 	if err != nil {
-		return clierror.Wrap(err, clierror.New("failed to build envs from file"))
+		return resources.CreateDeploymentOpts{}, clierror.Wrap(err, clierror.New("failed to build envs from file"))
 	}
 
 	plainEnvs := envs.Build(cfg.envs)
@@ -315,7 +744,7 @@ func createDeployment(cfg *appPushConfig, client kube.Client, image, imagePullSe
 	envs = append(envs, fileEnvs...)
 	envs = append(envs, plainEnvs...)
 
-	err = resources.ApplyDeployment(cfg.Ctx, client, resources.CreateDeploymentOpts{
+	return resources.CreateDeploymentOpts{
 		Name:                       cfg.name,
 		Namespace:                  cfg.namespace,
 		Image:                      image,
@@ -326,12 +755,20 @@ func createDeployment(cfg *appPushConfig, client kube.Client, image, imagePullSe
 		ServiceBindingSecretMounts: cfg.mountServiceBindingSecrets,
 		Envs:                       envs,
 		Insecure:                   cfg.insecure,
-	})
-	if err != nil {
-		return clierror.Wrap(err, clierror.New("failed to apply Deployment"))
+		Ports:                      cfg.appPorts(),
+		Replicas:                   nullableInt32(cfg.replicas),
+		Resources:                  cfg.resources,
+		LivenessProbePath:          cfg.livenessProbePath,
+		ReadinessProbePath:         cfg.readinessProbePath,
+	}, nil
+}
+
+func nullableInt32(value types.NullableInt64) *int32 {
+	if value.Value == nil {
+		return nil
 	}
 
-	return nil
+	return ptr.To(int32(*value.Value))
 }
 
 func buildAndImportImage(client kube.Client, cfg *appPushConfig, registryConfig *registry.InternalRegistryConfig) (string, clierror.Error) {
@@ -2,8 +2,13 @@ package app
 
 import (
 	"testing"
+	"time"
 
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 )
 
 func Test_appPushConfig_complete_buildTag(t *testing.T) {
@@ -106,3 +111,271 @@ func Test_resolveImageTag(t *testing.T) {
 		require.Regexp(t, `^\d{4}-\d{2}-\d{2}_\d{2}-\d{2}-\d{2}$`, resolvedTag)
 	})
 }
+
+func Test_appPushConfig_validate_scaling(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     appPushConfig
+		wantErr string
+	}{
+		{
+			name: "replicas",
+			cfg:  appPushConfig{replicas: types.NullableInt64{Value: ptr.To(int64(3))}},
+		},
+		{
+			name: "autoscaling with default min replicas",
+			cfg: appPushConfig{
+				autoscaleMaxReplicas:    types.NullableInt64{Value: ptr.To(int64(5))},
+				autoscaleCPUUtilization: 80,
+			},
+		},
+		{
+			name:    "negative replicas",
+			cfg:     appPushConfig{replicas: types.NullableInt64{Value: ptr.To(int64(-1))}},
+			wantErr: "invalid value of the --replicas flag",
+		},
+		{
+			name: "min replicas lower than 1",
+			cfg: appPushConfig{
+				autoscaleMinReplicas:    types.NullableInt64{Value: ptr.To(int64(0))},
+				autoscaleMaxReplicas:    types.NullableInt64{Value: ptr.To(int64(5))},
+				autoscaleCPUUtilization: 80,
+			},
+			wantErr: "invalid value of the --autoscale-min-replicas flag",
+		},
+		{
+			name: "max replicas lower than min replicas",
+			cfg: appPushConfig{
+				autoscaleMinReplicas:    types.NullableInt64{Value: ptr.To(int64(3))},
+				autoscaleMaxReplicas:    types.NullableInt64{Value: ptr.To(int64(2))},
+				autoscaleCPUUtilization: 80,
+			},
+			wantErr: "invalid value of the --autoscale-max-replicas flag",
+		},
+		{
+			name: "zero cpu utilization",
+			cfg: appPushConfig{
+				autoscaleMaxReplicas: types.NullableInt64{Value: ptr.To(int64(2))},
+			},
+			wantErr: "invalid value of the --autoscale-cpu-utilization flag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clierr := tt.cfg.validate()
+			if tt.wantErr == "" {
+				require.Nil(t, clierr)
+				return
+			}
+
+			require.NotNil(t, clierr)
+			require.Contains(t, clierr.String(), tt.wantErr)
+		})
+	}
+}
+
+func Test_appPushConfig_validate_resources(t *testing.T) {
+	t.Run("parse resources", func(t *testing.T) {
+		cfg := appPushConfig{cpuRequest: "100m", memoryLimit: "1Gi"}
+
+		clierr := cfg.validate()
+		require.Nil(t, clierr)
+		require.Equal(t, resources.ContainerResources{
+			CPURequest:  ptr.To(resource.MustParse("100m")),
+			MemoryLimit: ptr.To(resource.MustParse("1Gi")),
+		}, cfg.resources)
+	})
+
+	t.Run("invalid quantity", func(t *testing.T) {
+		cfg := appPushConfig{memoryRequest: "lots"}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid value 'lots' of the --memory-request flag")
+	})
+
+	t.Run("request exceeds default limit", func(t *testing.T) {
+		cfg := appPushConfig{cpuRequest: "1"}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "cpu request 1 exceeds cpu limit 300m")
+		require.Contains(t, clierr.String(), "use the --cpu-limit flag")
+	})
+}
+
+func Test_appPushConfig_validate_probes(t *testing.T) {
+	t.Run("invalid probe path", func(t *testing.T) {
+		cfg := appPushConfig{
+			containerPort:      types.NullableInt64{Value: ptr.To(int64(8080))},
+			livenessProbePath:  "/healthz",
+			readinessProbePath: "ready",
+		}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid value 'ready' of the --readiness-probe-path flag")
+	})
+
+	t.Run("missing http port", func(t *testing.T) {
+		cfg := appPushConfig{
+			ports:             types.PortArray{Ports: []types.PortSpec{{Name: "grpc", Port: 9000}}},
+			livenessProbePath: "/healthz",
+		}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the --liveness-probe-path flag requires a port using the http protocol")
+	})
+}
+
+func Test_appPushConfig_validate_ports(t *testing.T) {
+	ports := types.PortArray{Ports: []types.PortSpec{
+		{Name: "grpc", Port: 9000},
+		{Name: "web", Port: 8080},
+		{Name: "metrics", Port: 9090},
+	}}
+
+	t.Run("expose the first http port by default", func(t *testing.T) {
+		cfg := appPushConfig{ports: ports, expose: true}
+
+		require.Nil(t, cfg.validate())
+		require.Equal(t, &types.PortSpec{Name: "web", Port: 8080}, cfg.exposedPort())
+	})
+
+	t.Run("expose the chosen port", func(t *testing.T) {
+		cfg := appPushConfig{ports: ports, expose: true, exposePort: "grpc"}
+
+		require.Nil(t, cfg.validate())
+		require.Equal(t, &types.PortSpec{Name: "grpc", Port: 9000}, cfg.exposedPort())
+	})
+
+	t.Run("container port is the http port", func(t *testing.T) {
+		cfg := appPushConfig{containerPort: types.NullableInt64{Value: ptr.To(int64(3000))}, expose: true}
+
+		require.Nil(t, cfg.validate())
+		require.Equal(t, []types.PortSpec{{Name: "http", Port: 3000}}, cfg.appPorts())
+		require.Equal(t, &types.PortSpec{Name: "http", Port: 3000}, cfg.exposedPort())
+	})
+
+	t.Run("unknown exposed port", func(t *testing.T) {
+		cfg := appPushConfig{ports: ports, expose: true, exposePort: "admin"}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid value 'admin' of the --expose-port flag")
+		require.Contains(t, clierr.String(), "use the name of one of the app ports: grpc, web, metrics")
+	})
+
+	t.Run("no http port to expose", func(t *testing.T) {
+		cfg := appPushConfig{ports: types.PortArray{Ports: ports.Ports[:1]}, expose: true}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the app has no port using the http protocol to expose")
+	})
+
+	t.Run("invalid container port", func(t *testing.T) {
+		cfg := appPushConfig{containerPort: types.NullableInt64{Value: ptr.To(int64(0))}}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid value of the --container-port flag")
+	})
+}
+
+func Test_appPushConfig_validate_apiRule(t *testing.T) {
+	fixConfig := func() appPushConfig {
+		return appPushConfig{
+			name:          "my-app",
+			namespace:     "default",
+			containerPort: types.NullableInt64{Value: ptr.To(int64(8080))},
+			expose:        true,
+		}
+	}
+
+	t.Run("apiRule with jwt and cors", func(t *testing.T) {
+		cfg := fixConfig()
+		cfg.exposeHost = "api.example.com"
+		cfg.exposeMethods = []string{"get", "POST"}
+		cfg.exposePaths = []string{"/orders/{**}"}
+		cfg.jwtIssuer = "https://issuer.example.com"
+		cfg.jwtJWKSURI = "https://issuer.example.com/keys"
+		cfg.corsAllowOrigins = []string{"*"}
+		cfg.corsMaxAge = time.Minute
+
+		require.Nil(t, cfg.validate())
+		require.Equal(t, resources.CreateAPIRuleOpts{
+			Name:      "my-app",
+			Namespace: "default",
+			Host:      "api.example.com",
+			Port:      8080,
+			Methods:   []string{"get", "POST"},
+			Paths:     []string{"/orders/{**}"},
+			JWT: &resources.APIRuleJWT{
+				Issuer:  "https://issuer.example.com",
+				JWKSURI: "https://issuer.example.com/keys",
+			},
+			CORS: &resources.APIRuleCORS{
+				AllowOrigins: []string{"*"},
+				MaxAge:       time.Minute,
+			},
+		}, cfg.apiRuleOpts())
+	})
+
+	t.Run("app name is the default host", func(t *testing.T) {
+		cfg := fixConfig()
+
+		require.Nil(t, cfg.validate())
+		require.Equal(t, "my-app", cfg.apiRuleOpts().Host)
+		require.Nil(t, cfg.apiRuleOpts().CORS)
+	})
+
+	tests := []struct {
+		name    string
+		modify  func(cfg *appPushConfig)
+		wantErr string
+	}{
+		{
+			name:    "invalid subdomain",
+			modify:  func(cfg *appPushConfig) { cfg.exposeHost = "My_App" },
+			wantErr: "invalid value 'My_App' of the --expose-host flag",
+		},
+		{
+			name:    "invalid domain",
+			modify:  func(cfg *appPushConfig) { cfg.exposeHost = "api..example.com" },
+			wantErr: "invalid value 'api..example.com' of the --expose-host flag",
+		},
+		{
+			name:    "invalid method",
+			modify:  func(cfg *appPushConfig) { cfg.exposeMethods = []string{"GET", "FETCH"} },
+			wantErr: "invalid HTTP method 'FETCH'",
+		},
+		{
+			name:    "invalid cors method",
+			modify:  func(cfg *appPushConfig) { cfg.corsAllowMethods = []string{"ANY"} },
+			wantErr: "invalid HTTP method 'ANY'",
+		},
+		{
+			name:    "invalid path",
+			modify:  func(cfg *appPushConfig) { cfg.exposePaths = []string{"orders"} },
+			wantErr: "invalid value 'orders' of the --expose-path flag",
+		},
+		{
+			name:    "jwks uri without https",
+			modify:  func(cfg *appPushConfig) { cfg.jwtJWKSURI = "http://issuer.example.com/keys" },
+			wantErr: "invalid value 'http://issuer.example.com/keys' of the --jwt-jwks-uri flag",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := fixConfig()
+			tt.modify(&cfg)
+
+			clierr := cfg.validate()
+			require.NotNil(t, clierr)
+			require.Contains(t, clierr.String(), tt.wantErr)
+		})
+	}
+}
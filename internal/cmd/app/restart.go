@@ -0,0 +1,61 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+type appRestartConfig struct {
+	*cmdcommon.KymaConfig
+
+	name      string
+	namespace string
+}
+
+func NewAppRestartCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := appRestartConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restart <name> [flags]",
+		Short: "Restarts the app",
+		Long:  "Use this command to restart the app by rolling out new pods of its Deployment.",
+		Example: `  # Restart the my-app app
+  kyma app restart my-app --namespace my-namespace`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.name = args[0]
+			clierror.Check(runAppRestart(&cfg, out.Default))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Namespace where the app is deployed")
+
+	return cmd
+}
+
+func runAppRestart(cfg *appRestartConfig, printer *out.Printer) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	_, clierr = getApp(cfg.Ctx, client.Static(), cfg.name, cfg.namespace)
+	if clierr != nil {
+		return clierr
+	}
+
+	err := resources.RestartDeployment(cfg.Ctx, client.Static(), cfg.name, cfg.namespace)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to restart app %s/%s", cfg.namespace, cfg.name)))
+	}
+
+	printer.Msgfln("app %s/%s restarted", cfg.namespace, cfg.name)
+	return nil
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_runAppRestart(t *testing.T) {
+	t.Run("restart app", func(t *testing.T) {
+		kubeClient := fixKubeClient(fixAppDeployment("my-app", "default"))
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppRestart(&appRestartConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Equal(t, "app default/my-app restarted\n", buf.String())
+
+		deployment, err := kubeClient.Static().AppsV1().Deployments("default").Get(context.Background(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Contains(t, deployment.Spec.Template.Annotations, "kubectl.kubernetes.io/restartedAt")
+	})
+
+	t.Run("app not found", func(t *testing.T) {
+		clierr := runAppRestart(&appRestartConfig{
+			KymaConfig: fixKymaConfig(fixKubeClient()),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(bytes.NewBuffer([]byte{})))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app default/my-app not found")
+	})
+}
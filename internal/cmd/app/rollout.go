@@ -0,0 +1,293 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/out"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// number of the last container log lines printed for the failing pod
+	diagnosisLogLines = 20
+	// number of the last pod events printed for the failing pod
+	diagnosisEvents = 10
+)
+
+// interval of checking the rollout state, overridden in tests
+var rolloutPollInterval = 2 * time.Second
+
+// container waiting reasons that won't resolve without changing the app configuration
+var failingContainerReasons = []string{
+	"ErrImagePull",
+	"ImagePullBackOff",
+	"InvalidImageName",
+	"CreateContainerConfigError",
+	"CreateContainerError",
+	"CrashLoopBackOff",
+}
+
+// rolloutWatcher waits for the app rollout and diagnoses the failing pods
+type rolloutWatcher struct {
+	client  kubernetes.Interface
+	printer *out.Printer
+
+	name      string
+	namespace string
+	// secret used to pull the app image, used to give hints when pulling the image fails
+	imagePullSecret string
+}
+
+// podFailure describes the pod with the container that can't start
+type podFailure struct {
+	pod       *corev1.Pod
+	container corev1.ContainerStatus
+	reason    string
+}
+
+// wait prints the rollout progress until it's complete, fails, or the timeout is reached
+func (w *rolloutWatcher) wait(ctx context.Context, timeout time.Duration) clierror.Error {
+	lastMessage := ""
+	pods := []corev1.Pod{}
+	var failure *podFailure
+	var state rolloutState
+	err := wait.PollUntilContextTimeout(ctx, rolloutPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		deployment, err := w.client.AppsV1().Deployments(w.namespace).Get(ctx, w.name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		state = getRolloutState(deployment)
+		if state.message != lastMessage {
+			w.printer.Msgfln("  Rollout %s", state.message)
+			lastMessage = state.message
+		}
+		if state.complete || state.failed {
+			return true, nil
+		}
+
+		pods, err = w.listRolloutPods(ctx, deployment)
+		if err != nil {
+			return false, err
+		}
+
+		failure = findPodFailure(pods)
+		return failure != nil, nil
+	})
+
+	if err != nil && !wait.Interrupted(err) {
+		return clierror.Wrap(err, clierror.New("failed to check the app rollout state"))
+	}
+
+	if state.complete {
+		return nil
+	}
+
+	reason := "app rollout failed"
+	if err != nil {
+		reason = fmt.Sprintf("timed out after %s waiting for the app rollout", timeout)
+	}
+
+	if failure == nil {
+		// none of containers failed but pods may be not ready because of failing probes or scheduling issues
+		failure = findNotReadyPod(pods)
+	}
+
+	return w.diagnose(ctx, reason, failure)
+}
+
+// listRolloutPods returns pods of the newest ReplicaSet of the Deployment
+func (w *rolloutWatcher) listRolloutPods(ctx context.Context, deployment *appsv1.Deployment) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	replicaSets, err := w.client.AppsV1().ReplicaSets(w.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	revision := deployment.Annotations["deployment.kubernetes.io/revision"]
+	podTemplateHash := ""
+	for _, replicaSet := range replicaSets.Items {
+		if metav1.IsControlledBy(&replicaSet, deployment) && replicaSet.Annotations["deployment.kubernetes.io/revision"] == revision {
+			podTemplateHash = replicaSet.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+		}
+	}
+
+	if podTemplateHash == "" {
+		// the new ReplicaSet is not created yet
+		return nil, nil
+	}
+
+	pods, err := w.client.CoreV1().Pods(w.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s,%s=%s", selector.String(), appsv1.DefaultDeploymentUniqueLabelKey, podTemplateHash),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pods.Items, nil
+}
+
+// findPodFailure returns the first pod with the container that can't start
+func findPodFailure(pods []corev1.Pod) *podFailure {
+	for i := range pods {
+		for _, status := range pods[i].Status.ContainerStatuses {
+			if status.State.Waiting == nil || !slices.Contains(failingContainerReasons, status.State.Waiting.Reason) {
+				continue
+			}
+
+			reason := status.State.Waiting.Reason
+			if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.Reason == "OOMKilled" {
+				reason = terminated.Reason
+			}
+
+			return &podFailure{pod: &pods[i], container: status, reason: reason}
+		}
+	}
+
+	return nil
+}
+
+// findNotReadyPod returns the first pod that is not ready
+func findNotReadyPod(pods []corev1.Pod) *podFailure {
+	for i := range pods {
+		for _, status := range pods[i].Status.ContainerStatuses {
+			if !status.Ready {
+				return &podFailure{pod: &pods[i], container: status, reason: fmt.Sprintf("not ready (%s)", podStatus(&pods[i]))}
+			}
+		}
+
+		if len(pods[i].Status.ContainerStatuses) == 0 {
+			return &podFailure{pod: &pods[i], reason: fmt.Sprintf("not ready (%s)", podStatus(&pods[i]))}
+		}
+	}
+
+	return nil
+}
+
+// diagnose prints events and logs of the failing pod and returns the error with hints for typical causes
+func (w *rolloutWatcher) diagnose(ctx context.Context, reason string, failure *podFailure) clierror.Error {
+	if failure == nil {
+		return clierror.New(reason,
+			fmt.Sprintf("use the 'kyma app status %s --namespace %s' command to check the app pods", w.name, w.namespace),
+			"make sure the cluster has enough resources to schedule the app pods")
+	}
+
+	w.printer.Msgfln("\nPod %s/%s failed: %s", failure.pod.Namespace, failure.pod.Name, failure.reason)
+
+	events := w.listPodEvents(ctx, failure.pod)
+	if len(events) != 0 {
+		w.printer.Msgln("\nEvents:")
+		for _, event := range events {
+			w.printer.Msgfln("  %s\t%s\t%s", event.Type, event.Reason, event.Message)
+		}
+	}
+
+	// image pull failures and config errors don't produce logs
+	container := failure.container
+	if container.State.Running != nil || container.RestartCount > 0 {
+		logs := w.lastContainerLogs(ctx, failure)
+		if len(logs) != 0 {
+			w.printer.Msgfln("\nLast logs of the %s container:", failure.container.Name)
+			for _, line := range logs {
+				w.printer.Msgfln("  %s", line)
+			}
+		}
+	}
+
+	return clierror.Wrap(
+		fmt.Errorf("pod %s failed: %s", failure.pod.Name, failure.reason),
+		clierror.New(reason, w.failureHints(failure.reason, events)...),
+	)
+}
+
+// listPodEvents returns the last events of the pod or nothing if they can't be listed
+func (w *rolloutWatcher) listPodEvents(ctx context.Context, pod *corev1.Pod) []corev1.Event {
+	eventList, err := w.client.CoreV1().Events(pod.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("involvedObject.name", pod.Name).String(),
+	})
+	if err != nil {
+		out.Debugfln("failed to list events of the %s pod: %s", pod.Name, err.Error())
+		return nil
+	}
+
+	events := []corev1.Event{}
+	for _, event := range eventList.Items {
+		if event.InvolvedObject.Name == pod.Name {
+			events = append(events, event)
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b corev1.Event) int {
+		return a.LastTimestamp.Compare(b.LastTimestamp.Time)
+	})
+
+	return events[max(0, len(events)-diagnosisEvents):]
+}
+
+// lastContainerLogs returns the last log lines of the running or the previous container instance or nothing if they can't be read
+func (w *rolloutWatcher) lastContainerLogs(ctx context.Context, failure *podFailure) []string {
+	tailLines := int64(diagnosisLogLines)
+	logStream, err := w.client.CoreV1().Pods(failure.pod.Namespace).GetLogs(failure.pod.Name, &corev1.PodLogOptions{
+		Container: failure.container.Name,
+		Previous:  failure.container.State.Running == nil,
+		TailLines: &tailLines,
+	}).Stream(ctx)
+	if err != nil {
+		out.Debugfln("failed to get logs of the %s pod: %s", failure.pod.Name, err.Error())
+		return nil
+	}
+	defer logStream.Close()
+
+	lines := []string{}
+	err = readLines(logStream, func(line string) {
+		lines = append(lines, line)
+	})
+	if err != nil {
+		out.Debugfln("failed to read logs of the %s pod: %s", failure.pod.Name, err.Error())
+	}
+
+	return lines
+}
+
+// failureHints returns hints for typical causes of the rollout failure
+func (w *rolloutWatcher) failureHints(reason string, events []corev1.Event) []string {
+	hints := []string{}
+	switch reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+		hints = append(hints, "make sure the image name is correct and the image exists in the registry")
+		if w.imagePullSecret != "" {
+			hints = append(hints, fmt.Sprintf("make sure the %s Secret with the registry credentials exists in the %s namespace and contains valid credentials",
+				w.imagePullSecret, w.namespace))
+		}
+		hints = append(hints, "if the image is pushed to the in-cluster registry, make sure the Docker Registry module is ready")
+	case "CreateContainerConfigError", "CreateContainerError":
+		hints = append(hints, "make sure all Secrets and ConfigMaps used in envs and mounts exist in the app namespace")
+	case "OOMKilled":
+		hints = append(hints, "the app container was killed because it exceeded its memory limit, increase the memory limit or reduce the app memory usage")
+	case "CrashLoopBackOff":
+		hints = append(hints, "check the container logs above to find out why the app exits",
+			fmt.Sprintf("use the 'kyma app logs %s --namespace %s --previous' command to see logs of the crashed container", w.name, w.namespace))
+	default:
+		hints = append(hints, "make sure the cluster has enough resources to schedule the app pods",
+			"if the app needs more time to start, increase the value of the --timeout flag")
+	}
+
+	if slices.ContainsFunc(events, func(event corev1.Event) bool { return event.Reason == "Unhealthy" }) {
+		hints = append(hints, "the app probes are failing, make sure the app listens on the container port and responds to the probes")
+	}
+
+	return hints
+}
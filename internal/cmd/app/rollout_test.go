@@ -0,0 +1,145 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_rolloutWatcher_wait(t *testing.T) {
+	rolloutPollInterval = 10 * time.Millisecond
+
+	t.Run("rollout complete", func(t *testing.T) {
+		buf := bytes.NewBuffer([]byte{})
+		watcher := fixRolloutWatcher(buf, fixAppDeployment("my-app", "default"))
+
+		clierr := watcher.wait(context.Background(), time.Second)
+		require.Nil(t, clierr)
+		require.Equal(t, "  Rollout complete\n", buf.String())
+	})
+
+	t.Run("image pull failure", func(t *testing.T) {
+		pod := fixRolloutPod(corev1.ContainerStatus{
+			Name:  "my-app",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+		})
+		buf := bytes.NewBuffer([]byte{})
+		watcher := fixRolloutWatcher(buf, append(fixRolloutObjects(pod),
+			fixPodEvent("event-2", "Warning", "Failed", "Error: ImagePullBackOff", 2),
+			fixPodEvent("event-1", "Normal", "Pulling", "Pulling image \"my-app:1.0.0\"", 1),
+		)...)
+
+		clierr := watcher.wait(context.Background(), time.Second)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app rollout failed")
+		require.Contains(t, clierr.String(), "pod my-app-1 failed: ImagePullBackOff")
+		require.Contains(t, clierr.String(), "make sure the dockerregistry-config Secret with the registry credentials exists in the default namespace")
+		require.Equal(t, "  Rollout in progress (0 of 1 updated replicas are available)\n"+
+			"\nPod default/my-app-1 failed: ImagePullBackOff\n"+
+			"\nEvents:\n"+
+			"  Normal\tPulling\tPulling image \"my-app:1.0.0\"\n"+
+			"  Warning\tFailed\tError: ImagePullBackOff\n", buf.String())
+	})
+
+	t.Run("container killed because of the memory limit", func(t *testing.T) {
+		pod := fixRolloutPod(corev1.ContainerStatus{
+			Name:                 "my-app",
+			RestartCount:         3,
+			State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+		})
+		buf := bytes.NewBuffer([]byte{})
+		watcher := fixRolloutWatcher(buf, fixRolloutObjects(pod)...)
+
+		clierr := watcher.wait(context.Background(), time.Second)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "pod my-app-1 failed: OOMKilled")
+		require.Contains(t, clierr.String(), "increase the memory limit")
+		require.Contains(t, buf.String(), "\nLast logs of the my-app container:\n  fake logs\n")
+	})
+
+	t.Run("timeout because of failing probes", func(t *testing.T) {
+		pod := fixRolloutPod(corev1.ContainerStatus{
+			Name:  "my-app",
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		})
+		buf := bytes.NewBuffer([]byte{})
+		watcher := fixRolloutWatcher(buf, append(fixRolloutObjects(pod),
+			fixPodEvent("event-1", "Warning", "Unhealthy", "Readiness probe failed: connection refused", 1),
+		)...)
+
+		clierr := watcher.wait(context.Background(), 50*time.Millisecond)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "timed out after 50ms waiting for the app rollout")
+		require.Contains(t, clierr.String(), "pod my-app-1 failed: not ready (Running)")
+		require.Contains(t, clierr.String(), "the app probes are failing")
+		require.Contains(t, buf.String(), "\nLast logs of the my-app container:\n  fake logs\n")
+	})
+}
+
+func fixRolloutWatcher(buf *bytes.Buffer, objs ...runtime.Object) *rolloutWatcher {
+	return &rolloutWatcher{
+		client:          k8sfake.NewClientset(objs...),
+		printer:         out.NewToWriter(buf),
+		name:            "my-app",
+		namespace:       "default",
+		imagePullSecret: "dockerregistry-config",
+	}
+}
+
+// fixRolloutObjects returns the Deployment in progress with its ReplicaSet and the given pod
+func fixRolloutObjects(pod *corev1.Pod) []runtime.Object {
+	deployment := fixAppDeployment("my-app", "default")
+	deployment.UID = "deployment-uid"
+	deployment.Annotations = map[string]string{"deployment.kubernetes.io/revision": "2"}
+	deployment.Spec.Replicas = nil
+	deployment.Status = appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1}
+
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-app-abc",
+			Namespace:   "default",
+			Annotations: map[string]string{"deployment.kubernetes.io/revision": "2"},
+			Labels:      map[string]string{"app": "my-app", appsv1.DefaultDeploymentUniqueLabelKey: "abc"},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment")),
+			},
+		},
+	}
+
+	oldPod := fixAppPod("my-app-old", "my-app", "default")
+	oldPod.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = "old"
+	oldPod.Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}
+
+	return []runtime.Object{deployment, replicaSet, oldPod, pod}
+}
+
+func fixRolloutPod(status corev1.ContainerStatus) *corev1.Pod {
+	pod := fixAppPod("my-app-1", "my-app", "default")
+	pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = "abc"
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{status}
+	return pod
+}
+
+func fixPodEvent(name, eventType, reason, message string, minutes int) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "my-app-1", Namespace: "default"},
+		Type:           eventType,
+		Reason:         reason,
+		Message:        message,
+		LastTimestamp:  metav1.NewTime(time.Now().Add(time.Duration(minutes) * time.Minute)),
+	}
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/moby/patternmatcher"
+	"github.com/moby/patternmatcher/ignorefile"
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// sourceWatcher watches the app source directory and reports batches of changed files
+type sourceWatcher struct {
+	root      string
+	debounce  time.Duration
+	gitignore *ignore.GitIgnore
+	// nil if the source directory has no .dockerignore file
+	dockerignore *patternmatcher.PatternMatcher
+	watcher      *fsnotify.Watcher
+}
+
+func newSourceWatcher(root string, debounce time.Duration) (*sourceWatcher, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &sourceWatcher{
+		root:     root,
+		debounce: debounce,
+	}
+
+	err = w.loadIgnoreFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	w.watcher, err = fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	err = w.addDir(root)
+	if err != nil {
+		w.watcher.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// loadIgnoreFiles reads patterns from the .gitignore and .dockerignore files of the source directory
+func (w *sourceWatcher) loadIgnoreFiles() error {
+	// changes in the git metadata never affect the app image
+	gitignoreLines := []string{".git/"}
+	content, err := os.ReadFile(filepath.Join(w.root, ".gitignore"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read .gitignore file: %w", err)
+	}
+	if err == nil {
+		gitignoreLines = append(gitignoreLines, strings.Split(string(content), "\n")...)
+	}
+	w.gitignore = ignore.CompileIgnoreLines(gitignoreLines...)
+
+	dockerignoreFile, err := os.Open(filepath.Join(w.root, ".dockerignore"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open .dockerignore file: %w", err)
+	}
+	defer dockerignoreFile.Close()
+
+	patterns, err := ignorefile.ReadAll(dockerignoreFile)
+	if err != nil {
+		return fmt.Errorf("failed to read .dockerignore file: %w", err)
+	}
+
+	w.dockerignore, err = patternmatcher.New(patterns)
+	if err != nil {
+		return fmt.Errorf("invalid pattern in .dockerignore file: %w", err)
+	}
+
+	return nil
+}
+
+// ignored returns true if the path is excluded by the .gitignore or .dockerignore patterns
+func (w *sourceWatcher) ignored(path string, isDir bool) bool {
+	relPath, err := filepath.Rel(w.root, path)
+	if err != nil || relPath == "." {
+		return false
+	}
+	relPath = filepath.ToSlash(relPath)
+
+	gitignorePath := relPath
+	if isDir {
+		// directory patterns end with the slash
+		gitignorePath += "/"
+	}
+	if w.gitignore.MatchesPath(gitignorePath) {
+		return true
+	}
+
+	if w.dockerignore != nil {
+		matches, err := w.dockerignore.MatchesOrParentMatches(relPath)
+		return err == nil && matches
+	}
+
+	return false
+}
+
+// addDir watches the directory and all its subdirectories that are not ignored
+func (w *sourceWatcher) addDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// the directory was removed in the meantime
+				return nil
+			}
+			return err
+		}
+
+		if !entry.IsDir() {
+			return nil
+		}
+
+		if w.ignored(path, true) {
+			return filepath.SkipDir
+		}
+
+		err = w.watcher.Add(path)
+		if err != nil {
+			return fmt.Errorf("failed to watch directory %s: %w", path, err)
+		}
+
+		return nil
+	})
+}
+
+// run sends relative paths of the changed files when no more changes are detected for the debounce interval
+// changes made while the previous batch is not received yet are merged into the next batch
+func (w *sourceWatcher) run(ctx context.Context, changes chan<- []string, onError func(error)) {
+	defer w.watcher.Close()
+
+	pending := map[string]struct{}{}
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	// nil until the debounce interval passes, sending to the nil channel blocks forever
+	var ready chan<- []string
+	var batch []string
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			info, statErr := os.Stat(event.Name)
+			isDir := statErr == nil && info.IsDir()
+			if w.ignored(event.Name, isDir) || event.Op == fsnotify.Chmod {
+				continue
+			}
+
+			if isDir && event.Has(fsnotify.Create) {
+				if err := w.addDir(event.Name); err != nil {
+					onError(err)
+				}
+			}
+
+			relPath, _ := filepath.Rel(w.root, event.Name)
+			pending[filepath.ToSlash(relPath)] = struct{}{}
+			ready = nil
+			timer.Reset(w.debounce)
+		case <-timer.C:
+			ready = changes
+			batch = sortedKeys(pending)
+		case ready <- batch:
+			pending = map[string]struct{}{}
+			ready = nil
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			onError(err)
+		}
+	}
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
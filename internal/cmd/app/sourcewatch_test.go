@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sourceWatcher_ignored(t *testing.T) {
+	root := fixSourceDir(t, map[string]string{
+		".gitignore":    "node_modules/\n*.log\n!keep.log\n",
+		".dockerignore": "docs\n*.md\n",
+	})
+
+	watcher, err := newSourceWatcher(root, time.Millisecond)
+	require.NoError(t, err)
+	defer watcher.watcher.Close()
+
+	tests := []struct {
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{path: "main.go", ignored: false},
+		{path: "pkg/handler.go", ignored: false},
+		{path: ".git", isDir: true, ignored: true},
+		{path: "node_modules", isDir: true, ignored: true},
+		{path: "web/node_modules/lib/index.js", ignored: true},
+		{path: "debug.log", ignored: true},
+		{path: "logs/keep.log", ignored: false},
+		// .dockerignore patterns are relative to the source directory
+		{path: "docs/index.html", ignored: true},
+		{path: "README.md", ignored: true},
+		{path: "pkg/README.md", ignored: false},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.ignored, watcher.ignored(filepath.Join(root, tt.path), tt.isDir), tt.path)
+	}
+}
+
+func Test_sourceWatcher_run(t *testing.T) {
+	root := fixSourceDir(t, map[string]string{
+		".gitignore": "*.log\n",
+		"main.go":    "package main",
+	})
+
+	watcher, err := newSourceWatcher(root, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan []string)
+	go watcher.run(ctx, changes, func(err error) {
+		require.NoError(t, err)
+	})
+
+	// changes made one after another are sent in one batch
+	require.NoError(t, os.WriteFile(filepath.Join(root, "main.go"), []byte("package main\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "debug.log"), []byte("ignored"), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(root, "pkg"), 0700))
+	require.Equal(t, []string{"main.go", "pkg"}, fixReceiveChanges(t, changes))
+
+	// files in new directories are watched too
+	require.NoError(t, os.WriteFile(filepath.Join(root, "pkg", "handler.go"), []byte("package pkg"), 0600))
+	require.Equal(t, []string{"pkg/handler.go"}, fixReceiveChanges(t, changes))
+
+	// changes of ignored files don't trigger the rebuild
+	require.NoError(t, os.WriteFile(filepath.Join(root, "debug.log"), []byte("still ignored"), 0600))
+	select {
+	case changed := <-changes:
+		require.Fail(t, "unexpected changes", changed)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func fixSourceDir(t *testing.T, files map[string]string) string {
+	root := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(root, name), []byte(content), 0600))
+	}
+
+	return root
+}
+
+func fixReceiveChanges(t *testing.T, changes chan []string) []string {
+	select {
+	case changed := <-changes:
+		return changed
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for changes")
+		return nil
+	}
+}
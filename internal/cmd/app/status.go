@@ -0,0 +1,161 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+type appStatusConfig struct {
+	*cmdcommon.KymaConfig
+
+	name      string
+	namespace string
+}
+
+func NewAppStatusCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := appStatusConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status <name> [flags]",
+		Short: "Displays the status of the app",
+		Long:  "Use this command to display the rollout state, pods, and URL of the app pushed to the Kubernetes cluster.",
+		Example: `  # Display the status of the my-app app
+  kyma app status my-app --namespace my-namespace`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.name = args[0]
+			clierror.Check(runAppStatus(&cfg, out.Default))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Namespace where the app is deployed")
+
+	return cmd
+}
+
+func runAppStatus(cfg *appStatusConfig, printer *out.Printer) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	deployment, clierr := getApp(cfg.Ctx, client.Static(), cfg.name, cfg.namespace)
+	if clierr != nil {
+		return clierr
+	}
+
+	pods, clierr := listAppPods(cfg.Ctx, client.Static(), deployment)
+	if clierr != nil {
+		return clierr
+	}
+
+	url, clierr := getAppURL(cfg, client)
+	if clierr != nil {
+		return clierr
+	}
+
+	image := ""
+	if containers := deployment.Spec.Template.Spec.Containers; len(containers) > 0 {
+		image = containers[0].Image
+	}
+
+	printer.Msgfln("Name:       %s", deployment.Name)
+	printer.Msgfln("Namespace:  %s", deployment.Namespace)
+	printer.Msgfln("Image:      %s", image)
+	printer.Msgfln("Rollout:    %s", getRolloutState(deployment))
+	printer.Msgfln("URL:        %s", url)
+
+	if len(pods) == 0 {
+		printer.Msgln("\nno pods found")
+		return nil
+	}
+
+	printer.Msgln("\nPods:")
+	rows := make([][]interface{}, len(pods))
+	for i := range pods {
+		rows[i] = podRow(&pods[i])
+	}
+	render.Table(printer.MsgWriter(), []interface{}{"NAME", "READY", "STATUS", "RESTARTS", "AGE"}, rows)
+
+	return nil
+}
+
+// getAppURL returns the host of the VirtualService created for the app APIRule or the info that the app is not exposed
+func getAppURL(cfg *appStatusConfig, client kube.Client) (string, clierror.Error) {
+	apirule, err := client.RootlessDynamic().Get(cfg.Ctx, resources.AppAPIRule(cfg.name, cfg.namespace))
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) || (err == nil && !resources.IsCreatedByCLI(apirule.GetLabels())) {
+		return "not exposed", nil
+	}
+	if err != nil {
+		return "", clierror.Wrap(err, clierror.New("failed to get the APIRule of the app"))
+	}
+
+	hosts, _, _ := unstructured.NestedStringSlice(apirule.Object, "spec", "hosts")
+	if len(hosts) == 0 {
+		return "unknown", nil
+	}
+
+	url, clierr := getAPIRuleURL(cfg.Ctx, client, cfg.name, cfg.namespace, hosts[0])
+	if clierr != nil {
+		// fallback to the host defined in the APIRule
+		return hosts[0], nil
+	}
+
+	return url, nil
+}
+
+func podRow(pod *corev1.Pod) []interface{} {
+	ready := 0
+	restarts := int32(0)
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Ready {
+			ready++
+		}
+		restarts += status.RestartCount
+	}
+
+	return []interface{}{
+		pod.Name,
+		fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers)),
+		podStatus(pod),
+		fmt.Sprint(restarts),
+		duration.HumanDuration(time.Since(pod.CreationTimestamp.Time)),
+	}
+}
+
+// podStatus returns the pod phase or the reason why any of its containers is not running
+func podStatus(pod *corev1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+
+	reasons := []string{}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			reasons = append(reasons, status.State.Waiting.Reason)
+		} else if status.State.Terminated != nil && status.State.Terminated.Reason != "" {
+			reasons = append(reasons, status.State.Terminated.Reason)
+		}
+	}
+	if len(reasons) > 0 {
+		return strings.Join(reasons, ",")
+	}
+
+	return string(pod.Status.Phase)
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_runAppStatus(t *testing.T) {
+	t.Run("status of the exposed app", func(t *testing.T) {
+		failingPod := fixAppPod("my-app-2", "my-app", "default")
+		failingPod.Status.ContainerStatuses[0].Ready = false
+		failingPod.Status.ContainerStatuses[0].State.Waiting = &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}
+
+		kubeClient := fixKubeClient(
+			fixAppDeployment("my-app", "default"),
+			fixAppPod("my-app-1", "my-app", "default"),
+			failingPod,
+			fixAppPod("other-app-1", "other-app", "default"),
+			fixShootInfo("example.com"),
+		)
+		kubeClient.TestRootlessDynamicInterface = &kubefake.RootlessDynamicClient{
+			ReturnGetObj: fixAppAPIRule("my-app", "default"),
+		}
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppStatus(&appStatusConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Equal(t, "Name:       my-app\n"+
+			"Namespace:  default\n"+
+			"Image:      registry/my-app:1.0.0\n"+
+			"Rollout:    complete\n"+
+			"URL:        https://my-app.example.com\n"+
+			"\nPods:\n"+
+			"NAME       READY   STATUS             RESTARTS   AGE   \n"+
+			"my-app-1   1/1     Running            1          2m    \n"+
+			"my-app-2   0/1     CrashLoopBackOff   1          2m    \n", buf.String())
+	})
+
+	t.Run("host of the APIRule when cluster domain can't be resolved", func(t *testing.T) {
+		kubeClient := fixKubeClient(fixAppDeployment("my-app", "default"))
+		kubeClient.TestRootlessDynamicInterface = &kubefake.RootlessDynamicClient{
+			ReturnGetObj: fixAppAPIRule("my-app", "default"),
+		}
+		// user is not allowed to watch VirtualServices
+		kubeClient.TestKubernetesInterface.(*k8sfake.Clientset).PrependReactor("create", "selfsubjectaccessreviews",
+			func(_ k8stesting.Action) (bool, runtime.Object, error) {
+				return true, &authv1.SelfSubjectAccessReview{}, nil
+			})
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppStatus(&appStatusConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Contains(t, buf.String(), "URL:        my-app\n")
+	})
+
+	t.Run("status of the not exposed app", func(t *testing.T) {
+		kubeClient := fixKubeClient(fixAppDeployment("my-app", "default"))
+		kubeClient.TestRootlessDynamicInterface = &kubefake.RootlessDynamicClient{
+			ReturnGetErr: apierrors.NewNotFound(schema.GroupResource{Resource: "apirules"}, "my-app"),
+		}
+
+		buf := bytes.NewBuffer([]byte{})
+		clierr := runAppStatus(&appStatusConfig{
+			KymaConfig: fixKymaConfig(kubeClient),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(buf))
+		require.Nil(t, clierr)
+		require.Contains(t, buf.String(), "URL:        not exposed\n")
+		require.Contains(t, buf.String(), "\nno pods found\n")
+	})
+
+	t.Run("app not found", func(t *testing.T) {
+		clierr := runAppStatus(&appStatusConfig{
+			KymaConfig: fixKymaConfig(fixKubeClient()),
+			name:       "my-app",
+			namespace:  "default",
+		}, out.NewToWriter(bytes.NewBuffer([]byte{})))
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "app default/my-app not found")
+	})
+}
+
+func fixAppAPIRule(name, namespace string) unstructured.Unstructured {
+	apirule := resources.AppAPIRule(name, namespace)
+	apirule.SetLabels(resources.AppLabels(name))
+	_ = unstructured.SetNestedSlice(apirule.Object, []interface{}{name}, "spec", "hosts")
+	return *apirule
+}
+
+func fixShootInfo(domain string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "shoot-info", Namespace: "kube-system"},
+		Data:       map[string]string{"domain": domain},
+	}
+}
@@ -0,0 +1,24 @@
+package extension
+
+import (
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/cobra"
+)
+
+func NewExtensionCMD(builder *extensions.Builder, availableActions types.ActionsMap) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "extension <command> [flags]",
+		Aliases: []string{"extensions"},
+		Short:   "Manages Kyma CLI extensions",
+		Long:    `Use this command to list, inspect, validate, and render Kyma CLI extensions, and check their compatibility.`,
+	}
+
+	cmd.AddCommand(newListCMD(builder))
+	cmd.AddCommand(newInspectCMD(builder))
+	cmd.AddCommand(newVersionsCMD(builder, availableActions))
+	cmd.AddCommand(newValidateCMD(availableActions))
+	cmd.AddCommand(newRenderCMD(builder, availableActions))
+
+	return cmd
+}
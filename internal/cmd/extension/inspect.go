@@ -0,0 +1,65 @@
+package extension
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+func newInspectCMD(builder *extensions.Builder) *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect <extension_name> [flags]",
+		Short: "Displays details of a discovered extension",
+		Long:  "Use this command to display the source, status, load error, and definition of a discovered extension.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			clierror.Check(runInspect(builder, args[0]))
+		},
+	}
+}
+
+func runInspect(builder *extensions.Builder, name string) clierror.Error {
+	status := findExtensionStatus(builder, name)
+	if status == nil {
+		return clierror.New(
+			fmt.Sprintf("extension '%s' not found", name),
+			"use the 'kyma extension list' command to see all discovered extensions",
+		)
+	}
+
+	definition, err := yaml.Marshal(status.Extension)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to marshal extension definition"))
+	}
+
+	info := toExtensionInfo(*status)
+	out.Msgfln("Name:     %s", info.Name)
+	out.Msgfln("Source:   %s", info.Source)
+	out.Msgfln("Actions:  %s", strings.Join(info.Actions, ", "))
+	out.Msgfln("Status:   %s", info.Status)
+	if info.Error != "" {
+		out.Msgfln("Error:\n  %s", strings.ReplaceAll(info.Error, "\n", "\n  "))
+	}
+	out.Msgfln("\nDefinition:\n%s", string(definition))
+
+	return nil
+}
+
+// findExtensionStatus returns the extension status by the extension or the root command name
+func findExtensionStatus(builder *extensions.Builder, name string) *extensions.ExtensionStatus {
+	statuses := builder.Statuses()
+	for i := range statuses {
+		extensionName := statuses[i].Extension.Metadata.Name
+		commandName, _, _ := strings.Cut(extensionName, " ")
+		if extensionName == name || commandName == name {
+			return &statuses[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package extension
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type listConfig struct {
+	builder      *extensions.Builder
+	outputFormat types.Format
+}
+
+type extensionInfo struct {
+	Name    string   `json:"name" yaml:"name"`
+	Source  string   `json:"source" yaml:"source"`
+	Actions []string `json:"actions" yaml:"actions"`
+	Status  string   `json:"status" yaml:"status"`
+	Error   string   `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+func newListCMD(builder *extensions.Builder) *cobra.Command {
+	cfg := listConfig{
+		builder: builder,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: "Lists discovered extensions",
+		Long:  "Use this command to list all extensions discovered in the target Kyma environment and in local files, including extension ConfigMaps refused by the trust policy or invalid.",
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runList(&cfg))
+		},
+	}
+
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: table, json, yaml)")
+
+	return cmd
+}
+
+func runList(cfg *listConfig) clierror.Error {
+	infos := []extensionInfo{}
+	for _, status := range cfg.builder.Statuses() {
+		infos = append(infos, toExtensionInfo(status))
+	}
+	for _, rejected := range cfg.builder.Rejected() {
+		infos = append(infos, toRejectedExtensionInfo(rejected))
+	}
+
+	switch cfg.outputFormat {
+	case types.JSONFormat:
+		obj, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to marshal extensions"))
+		}
+		out.Msgln(string(obj))
+	case types.YAMLFormat:
+		obj, err := yaml.Marshal(infos)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to marshal extensions"))
+		}
+		out.Msgln(string(obj))
+	default:
+		rows := [][]interface{}{}
+		for _, info := range infos {
+			rows = append(rows, []interface{}{info.Name, info.Source, strings.Join(info.Actions, ", "), info.Status, info.Error})
+		}
+		render.Table(out.Default, []interface{}{"NAME", "SOURCE", "ACTIONS", "STATUS", "REASON"}, rows)
+	}
+
+	return nil
+}
+
+func toExtensionInfo(status extensions.ExtensionStatus) extensionInfo {
+	info := extensionInfo{
+		Name:    status.Extension.Metadata.Name,
+		Source:  status.Source(),
+		Actions: extensions.ListActions(status.Extension),
+		Status:  "Loaded",
+	}
+
+	if status.Error != nil {
+		info.Status = "Failed"
+		info.Error = status.Error.Error()
+	}
+
+	return info
+}
+
+func toRejectedExtensionInfo(rejected extensions.RejectedExtension) extensionInfo {
+	info := extensionInfo{
+		Source:  rejected.Source(),
+		Actions: []string{},
+		Status:  "Invalid",
+		Error:   rejected.Reason,
+	}
+
+	if rejected.Refused {
+		info.Status = "Refused"
+	}
+
+	return info
+}
@@ -0,0 +1,38 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_toRejectedExtensionInfo(t *testing.T) {
+	t.Run("refused configmap", func(t *testing.T) {
+		info := toRejectedExtensionInfo(extensions.RejectedExtension{
+			ConfigMapName:      "untrusted",
+			ConfigMapNamespace: "default",
+			Refused:            true,
+			Reason:             "namespace 'default' is not allowed",
+		})
+
+		require.Equal(t, extensionInfo{
+			Source:  "configmap 'default/untrusted'",
+			Actions: []string{},
+			Status:  "Refused",
+			Error:   "namespace 'default' is not allowed",
+		}, info)
+	})
+
+	t.Run("invalid configmap", func(t *testing.T) {
+		info := toRejectedExtensionInfo(extensions.RejectedExtension{
+			ConfigMapName:      "broken",
+			ConfigMapNamespace: "kyma-system",
+			Reason:             "missing .data.kyma-commands.yaml field",
+		})
+
+		require.Equal(t, "Invalid", info.Status)
+		require.Equal(t, "configmap 'kyma-system/broken'", info.Source)
+		require.Equal(t, "missing .data.kyma-commands.yaml field", info.Error)
+	})
+}
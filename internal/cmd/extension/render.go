@@ -0,0 +1,131 @@
+package extension
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+type renderConfig struct {
+	builder          *extensions.Builder
+	availableActions types.ActionsMap
+
+	file        string
+	commandPath []string
+	commandArgs []string
+}
+
+func newRenderCMD(builder *extensions.Builder, availableActions types.ActionsMap) *cobra.Command {
+	cfg := renderConfig{
+		builder:          builder,
+		availableActions: availableActions,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "render <command_path>... [flags] [-- <command_args_and_flags>]",
+		Short: "Renders the action configuration of an extension command",
+		Long:  "Use this command to render the templated action configuration (the 'with' field) of an extension command for the given args and flag values without running the action.",
+		Example: `  # Render the configuration of the 'kyma function create' command from the cluster
+  kyma extension render function create -- my-function --runtime nodejs22
+
+  # Render the configuration of the command defined in a local file
+  kyma extension render function create --file ./kyma-commands.yaml -- my-function`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg.complete(cmd, args)
+			clierror.Check(runRender(&cfg))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.file, "file", "f", "", "Path to the local file with the extension definition")
+
+	return cmd
+}
+
+func (rc *renderConfig) complete(cmd *cobra.Command, args []string) {
+	rc.commandPath = args
+	rc.commandArgs = []string{}
+	if dashIndex := cmd.ArgsLenAtDash(); dashIndex >= 0 {
+		rc.commandPath = args[:dashIndex]
+		rc.commandArgs = args[dashIndex:]
+	}
+}
+
+func runRender(cfg *renderConfig) clierror.Error {
+	if len(cfg.commandPath) == 0 {
+		return clierror.New("command path is empty", "provide at least the extension name before the '--' separator")
+	}
+
+	extension, clierr := getExtension(cfg)
+	if clierr != nil {
+		return clierr
+	}
+
+	// replace all actions with the one that prints the rendered config instead of running
+	renderActions := types.ActionsMap{}
+	for id := range cfg.availableActions {
+		renderActions[id] = &renderAction{}
+	}
+
+	cmd, err := extensions.BuildCommand(*extension, renderActions)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to build extension command"))
+	}
+
+	if cmd.Name() != cfg.commandPath[0] {
+		return clierror.New(fmt.Sprintf("extension '%s' does not match the '%s' command", extension.Metadata.Name, cfg.commandPath[0]))
+	}
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	cmd.SetArgs(append(cfg.commandPath[1:], cfg.commandArgs...))
+	err = cmd.Execute()
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render extension command"))
+	}
+
+	return nil
+}
+
+func getExtension(cfg *renderConfig) (*types.Extension, clierror.Error) {
+	if cfg.file != "" {
+		extension, err := extensions.LoadFromFile(cfg.file)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to load extension"))
+		}
+
+		return &extension.Extension, nil
+	}
+
+	status := findExtensionStatus(cfg.builder, cfg.commandPath[0])
+	if status == nil {
+		return nil, clierror.New(
+			fmt.Sprintf("extension '%s' not found", cfg.commandPath[0]),
+			"use the 'kyma extension list' command to see all discovered extensions",
+			"use the --file flag to render the extension from a local file",
+		)
+	}
+
+	return &status.Extension, nil
+}
+
+// renderAction prints the templated config instead of running the action
+type renderAction struct {
+	config []byte
+}
+
+func (a *renderAction) Configure(cfg types.ActionConfig, overwrites types.ActionConfigOverwrites) clierror.Error {
+	var clierr clierror.Error
+	a.config, clierr = common.RenderConfig(cfg, overwrites)
+	return clierr
+}
+
+func (a *renderAction) Run(_ *cobra.Command, _ []string) clierror.Error {
+	out.Msg(string(a.config))
+	return nil
+}
@@ -0,0 +1,43 @@
+package extension
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+func newValidateCMD(availableActions types.ActionsMap) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate <file> [flags]",
+		Short: "Validates an extension from a local file",
+		Long:  "Use this command to validate the extension definition from a local file and configure all its actions offline using default flag values.",
+		Example: `  # Validate the extension definition
+  kyma extension validate ./kyma-commands.yaml`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			clierror.Check(runValidate(args[0], availableActions))
+		},
+	}
+}
+
+func runValidate(path string, availableActions types.ActionsMap) clierror.Error {
+	extension, err := extensions.LoadFromFile(path)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to load extension"))
+	}
+
+	err = extensions.Validate(extension.Extension, availableActions)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(
+			fmt.Sprintf("extension from %s is invalid", extension.Source()),
+			"ensure the CLI version is compatible with the extension",
+		))
+	}
+
+	out.Msgfln("Extension '%s' from %s is valid", extension.Extension.Metadata.Name, extension.Source())
+	return nil
+}
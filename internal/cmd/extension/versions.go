@@ -0,0 +1,111 @@
+package extension
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmd/version"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	extensionstypes "github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type versionsConfig struct {
+	builder          *extensions.Builder
+	availableActions extensionstypes.ActionsMap
+	outputFormat     types.Format
+}
+
+type extensionVersionInfo struct {
+	Name               string   `json:"name" yaml:"name"`
+	Source             string   `json:"source" yaml:"source"`
+	APIVersion         string   `json:"apiVersion" yaml:"apiVersion"`
+	MinCLIVersion      string   `json:"minCliVersion,omitempty" yaml:"minCliVersion,omitempty"`
+	Compatible         bool     `json:"compatible" yaml:"compatible"`
+	Incompatibility    string   `json:"incompatibility,omitempty" yaml:"incompatibility,omitempty"`
+	UnsupportedActions []string `json:"unsupportedActions,omitempty" yaml:"unsupportedActions,omitempty"`
+}
+
+func newVersionsCMD(builder *extensions.Builder, availableActions extensionstypes.ActionsMap) *cobra.Command {
+	cfg := versionsConfig{
+		builder:          builder,
+		availableActions: availableActions,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "versions [flags]",
+		Short: "Lists versions of discovered extensions and their compatibility",
+		Long:  "Use this command to list schema versions and minimal required CLI versions of extensions available in the target Kyma environment and in local files, and check if they are compatible with the current Kyma CLI version.",
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runVersions(&cfg))
+		},
+	}
+
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: table, json, yaml)")
+
+	return cmd
+}
+
+func runVersions(cfg *versionsConfig) clierror.Error {
+	infos := []extensionVersionInfo{}
+	for _, status := range cfg.builder.Statuses() {
+		infos = append(infos, toExtensionVersionInfo(status, cfg.availableActions))
+	}
+
+	switch cfg.outputFormat {
+	case types.JSONFormat:
+		obj, err := json.MarshalIndent(infos, "", "  ")
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to marshal extensions"))
+		}
+		out.Msgln(string(obj))
+	case types.YAMLFormat:
+		obj, err := yaml.Marshal(infos)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to marshal extensions"))
+		}
+		out.Msgln(string(obj))
+	default:
+		out.Msgfln("Kyma CLI version: %s\n", version.GetVersion())
+
+		rows := [][]interface{}{}
+		for _, info := range infos {
+			compatible := "yes"
+			if !info.Compatible {
+				compatible = "no"
+			}
+			rows = append(rows, []interface{}{info.Name, info.Source, info.APIVersion, info.MinCLIVersion, compatible})
+		}
+		render.Table(out.Default, []interface{}{"NAME", "SOURCE", "API VERSION", "MIN CLI VERSION", "COMPATIBLE"}, rows)
+	}
+
+	return nil
+}
+
+func toExtensionVersionInfo(status extensions.ExtensionStatus, availableActions extensionstypes.ActionsMap) extensionVersionInfo {
+	info := extensionVersionInfo{
+		Name:               status.Extension.Metadata.Name,
+		Source:             status.Source(),
+		APIVersion:         extensions.ExtensionAPIVersion(status.Extension),
+		MinCLIVersion:      status.Extension.MinCLIVersion,
+		UnsupportedActions: extensions.UnsupportedActions(status.Extension, availableActions),
+	}
+
+	incompatibility := []string{}
+	if err := extensions.CheckCompatibility(status.Extension); err != nil {
+		incompatibility = append(incompatibility, err.Error())
+	}
+	if len(info.UnsupportedActions) != 0 {
+		incompatibility = append(incompatibility, "unsupported actions: "+strings.Join(info.UnsupportedActions, ", "))
+	}
+
+	info.Compatible = len(incompatibility) == 0
+	info.Incompatibility = strings.Join(incompatibility, "; ")
+
+	return info
+}
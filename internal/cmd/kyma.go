@@ -3,6 +3,7 @@ package cmd
 import (
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha"
 	"github.com/kyma-project/cli.v3/internal/cmd/app"
+	"github.com/kyma-project/cli.v3/internal/cmd/extension"
 	"github.com/kyma-project/cli.v3/internal/cmd/module"
 	"github.com/kyma-project/cli.v3/internal/cmd/version"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
@@ -38,18 +39,32 @@ func NewKymaCMD() *cobra.Command {
 	cmd.AddCommand(module.NewModuleCMD(kymaConfig))
 	cmd.AddCommand(app.NewAppCMD(kymaConfig))
 
-	builder := extensions.NewBuilder(kymaConfig)
-	builder.Build(cmd, extensionstypes.ActionsMap{
+	availableActions := extensionstypes.ActionsMap{
 		"function_init":         actions.NewFunctionInit(kymaConfig),
 		"registry_config":       actions.NewRegistryConfig(kymaConfig),
 		"registry_image_import": actions.NewRegistryImageImport(kymaConfig),
 		"resource_create":       actions.NewResourceCreate(kymaConfig),
 		"resource_get":          actions.NewResourceGet(kymaConfig),
 		"resource_delete":       actions.NewResourceDelete(kymaConfig),
+		"resource_patch":        actions.NewResourcePatch(kymaConfig),
+		"resource_apply":        actions.NewResourceApply(kymaConfig),
+		"resource_wait":         actions.NewResourceWait(kymaConfig),
 		"resource_explain":      actions.NewResourceExplain(),
+		"resource_logs":         actions.NewResourceLogs(kymaConfig),
+		"resource_exec":         actions.NewResourceExec(kymaConfig),
 		"call_files_to_save":    actions.NewCallFilesToSaveAction(kymaConfig),
-	})
+		"http_request":          actions.NewHTTPRequest(kymaConfig),
+	}
+
+	builder := extensions.NewBuilder(kymaConfig)
+	cmd.AddCommand(extension.NewExtensionCMD(builder, availableActions))
+	builder.Build(cmd, availableActions)
 	builder.DisplayWarnings()
+	// don't exit before the cache of extensions is revalidated
+	// hook is registered on the root command only and affects its children
+	cmd.PersistentPostRun = func(_ *cobra.Command, _ []string) {
+		builder.Wait()
+	}
 
 	return cmd
 }
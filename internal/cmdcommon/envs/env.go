@@ -2,6 +2,8 @@ package envs
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 	corev1 "k8s.io/api/core/v1"
@@ -9,10 +11,11 @@ import (
 
 func Build(envs types.EnvMap) []corev1.EnvVar {
 	var result []corev1.EnvVar
-	for k, v := range envs.Values {
+	// sort envs to build the same Deployment every time
+	for _, k := range slices.Sorted(maps.Keys(envs.Values)) {
 		result = append(result, corev1.EnvVar{
 			Name:  k,
-			Value: fmt.Sprintf("%v", v),
+			Value: fmt.Sprintf("%v", envs.Values[k]),
 		})
 	}
 
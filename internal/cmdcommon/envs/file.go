@@ -2,6 +2,8 @@ package envs
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/joho/godotenv"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
@@ -42,10 +44,10 @@ func BuildFromFile(envs types.SourcedEnvArray) ([]corev1.EnvVar, error) {
 
 func buildAllFileEnvs(data map[string]string, prefix string) []corev1.EnvVar {
 	var result []corev1.EnvVar
-	for key, value := range data {
+	for _, key := range slices.Sorted(maps.Keys(data)) {
 		result = append(result, corev1.EnvVar{
 			Name:  fmt.Sprintf("%s%s", prefix, key),
-			Value: value,
+			Value: data[key],
 		})
 	}
 
@@ -3,6 +3,8 @@ package envs
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 	"github.com/kyma-project/cli.v3/internal/kube"
@@ -61,7 +63,7 @@ func getSecretData(ctx context.Context, client kube.Client, namespace, name stri
 
 func buildSecretAllKeyEnvs(data map[string][]byte, resName string, prefix string) []corev1.EnvVar {
 	var result []corev1.EnvVar
-	for k := range data {
+	for _, k := range slices.Sorted(maps.Keys(data)) {
 		result = append(result, corev1.EnvVar{
 			Name: fmt.Sprintf("%s%s", prefix, k),
 			ValueFrom: &corev1.EnvVarSource{
@@ -17,6 +17,8 @@ type OneOfStringList struct {
 	message    string
 	promptText string
 	values     []string
+	// accept empty input
+	optional bool
 }
 
 func NewOneOfStringList(message, promptText string, values []string) *OneOfStringList {
@@ -29,6 +31,13 @@ func NewOneOfStringList(message, promptText string, values []string) *OneOfStrin
 	}
 }
 
+// NewOptionalOneOfStringList returns prompt that returns empty value if the user skips it
+func NewOptionalOneOfStringList(message, promptText string, values []string) *OneOfStringList {
+	l := NewOneOfStringList(message, promptText, values)
+	l.optional = true
+	return l
+}
+
 func (l *OneOfStringList) Prompt() (string, error) {
 	l.printer.Msgf("%s\n%s\n\n%s", l.message, l.valuesListString(), l.promptText)
 	scanner := bufio.NewScanner(l.reader)
@@ -58,6 +67,9 @@ func (l *OneOfStringList) valuesListString() string {
 }
 
 func (l *OneOfStringList) validateUserInput(userInput string) (string, error) {
+	if strings.TrimSpace(userInput) == "" && l.optional {
+		return "", nil
+	}
 	if strings.TrimSpace(userInput) == "" {
 		return "", fmt.Errorf("no value was selected")
 	}
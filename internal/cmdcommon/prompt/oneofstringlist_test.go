@@ -17,6 +17,7 @@ func TestOneOfStringListPrompt_Table(t *testing.T) {
 		inputReader io.Reader
 		values      []string
 		parseFunc   func(string) (string, error)
+		optional    bool
 		want        string
 		wantErr     string
 		wantOutput  string
@@ -45,6 +46,14 @@ func TestOneOfStringListPrompt_Table(t *testing.T) {
 			wantErr:     "no value was selected",
 			wantOutput:  "Select a fruit:\n - apple\n - banana\n - orange\n\nType the version number: \n",
 		},
+		{
+			name:        "Empty input of optional prompt",
+			inputReader: bytes.NewBufferString("\n"),
+			values:      []string{"apple", "banana", "orange"},
+			optional:    true,
+			want:        "",
+			wantOutput:  "Select a fruit:\n - apple\n - banana\n - orange\n\nType the version number: \n",
+		},
 		{
 			name:        "Invalid reader",
 			inputReader: iotest.ErrReader(errors.New("test error")),
@@ -65,6 +74,7 @@ func TestOneOfStringListPrompt_Table(t *testing.T) {
 				message:    "Select a fruit:",
 				promptText: "Type the version number: ",
 				values:     tc.values,
+				optional:   tc.optional,
 			}
 
 			got, err := listPrompt.Prompt()
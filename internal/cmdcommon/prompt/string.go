@@ -0,0 +1,79 @@
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+)
+
+type String struct {
+	reader       io.Reader
+	printer      *out.Printer
+	message      string
+	defaultValue string
+	// accept empty input without the default value
+	optional bool
+}
+
+func NewString(message, defaultValue string) *String {
+	return &String{
+		reader:       os.Stdin,
+		printer:      out.Default,
+		message:      message,
+		defaultValue: defaultValue,
+	}
+}
+
+// NewOptionalString returns prompt that returns empty value if the user skips it
+func NewOptionalString(message, defaultValue string) *String {
+	s := NewString(message, defaultValue)
+	s.optional = true
+	return s
+}
+
+func (s *String) Prompt() (string, error) {
+	s.printer.Msgf("%s%s: ", s.message, s.defaultValueDisplay())
+
+	scanner := bufio.NewScanner(s.reader)
+	scanner.Scan()
+	err := scanner.Err()
+	userInput := scanner.Text()
+	s.printer.Msg("\n")
+
+	if err != nil {
+		return "", err
+	}
+
+	return s.validateUserInput(userInput)
+}
+
+func (s *String) defaultValueDisplay() string {
+	if s.defaultValue == "" && s.optional {
+		return " (optional)"
+	}
+	if s.defaultValue == "" {
+		return ""
+	}
+	return fmt.Sprintf(" [%s]", s.defaultValue)
+}
+
+func (s *String) validateUserInput(userInput string) (string, error) {
+	userInput = strings.TrimSpace(userInput)
+	if userInput != "" {
+		return userInput, nil
+	}
+
+	if s.defaultValue != "" {
+		return s.defaultValue, nil
+	}
+
+	if s.optional {
+		return "", nil
+	}
+
+	return "", fmt.Errorf("no value was provided")
+}
@@ -0,0 +1,88 @@
+package prompt
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringPrompt_Table(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputReader   io.Reader
+		defaultValue  string
+		optional      bool
+		expectResult  string
+		expectMessage string
+		expectErr     string
+	}{
+		{
+			name:          "Value",
+			inputReader:   bytes.NewBufferString("my-app\n"),
+			expectResult:  "my-app",
+			expectMessage: "Name: \n",
+		},
+		{
+			name:          "Value with whitespaces",
+			inputReader:   bytes.NewBufferString("  my-app \t\n"),
+			defaultValue:  "default",
+			expectResult:  "my-app",
+			expectMessage: "Name [default]: \n",
+		},
+		{
+			name:          "Default with empty input",
+			inputReader:   bytes.NewBufferString("\n"),
+			defaultValue:  "default",
+			expectResult:  "default",
+			expectMessage: "Name [default]: \n",
+		},
+		{
+			name:          "Empty input without default",
+			inputReader:   bytes.NewBufferString(""),
+			expectMessage: "Name: \n",
+			expectErr:     "no value was provided",
+		},
+		{
+			name:          "Empty input of optional prompt",
+			inputReader:   bytes.NewBufferString("\n"),
+			optional:      true,
+			expectResult:  "",
+			expectMessage: "Name (optional): \n",
+		},
+		{
+			name:          "Erroneous input",
+			inputReader:   iotest.ErrReader(errors.New("test error")),
+			expectMessage: "Name: \n",
+			expectErr:     "test error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			output := bytes.NewBuffer([]byte{})
+			s := String{
+				reader:       tc.inputReader,
+				printer:      out.NewToWriter(output),
+				message:      "Name",
+				defaultValue: tc.defaultValue,
+				optional:     tc.optional,
+			}
+
+			result, err := s.Prompt()
+
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				require.Equal(t, tc.expectErr, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectResult, result)
+			require.Equal(t, tc.expectMessage, output.String())
+		})
+	}
+}
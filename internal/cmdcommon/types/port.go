@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// PortSpec represents a named port of the app container
+type PortSpec struct {
+	Name string
+	Port int32
+}
+
+// PortArray holds an array of named ports in the order they were set
+type PortArray struct {
+	Ports []PortSpec
+}
+
+// String returns the string representation
+func (p *PortArray) String() string {
+	parts := make([]string, len(p.Ports))
+	for i, port := range p.Ports {
+		parts[i] = fmt.Sprintf("%s=%d", port.Name, port.Port)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Type returns the type name
+func (p *PortArray) Type() string {
+	return "stringArray"
+}
+
+// Set parses and sets a port in the format NAME=PORT
+func (p *PortArray) Set(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	elems := strings.Split(value, "=")
+	if len(elems) != 2 {
+		return fmt.Errorf("failed to parse value '%s', should be in format NAME=PORT", value)
+	}
+
+	name := elems[0]
+	if errs := validation.IsValidPortName(name); len(errs) != 0 {
+		return fmt.Errorf("invalid port name '%s': %s", name, strings.Join(errs, ", "))
+	}
+
+	port, err := strconv.ParseInt(elems[1], 10, 32)
+	if err != nil || validation.IsValidPortNum(int(port)) != nil {
+		return fmt.Errorf("invalid port number '%s', should be between 1 and 65535", elems[1])
+	}
+
+	for _, existing := range p.Ports {
+		if existing.Name == name {
+			return fmt.Errorf("duplicate port name: '%s' is provided multiple times", name)
+		}
+		if existing.Port == int32(port) {
+			return fmt.Errorf("duplicate port number: '%d' is provided multiple times", port)
+		}
+	}
+
+	p.Ports = append(p.Ports, PortSpec{Name: name, Port: int32(port)})
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortArray(t *testing.T) {
+	t.Run("set values", func(t *testing.T) {
+		ports := PortArray{}
+		require.NoError(t, ports.Set("http=8080"))
+		require.NoError(t, ports.Set("metrics=9090"))
+		require.NoError(t, ports.Set(""))
+
+		require.Equal(t, []PortSpec{
+			{Name: "http", Port: 8080},
+			{Name: "metrics", Port: 9090},
+		}, ports.Ports)
+		require.Equal(t, "http=8080,metrics=9090", ports.String())
+		require.Equal(t, "stringArray", ports.Type())
+	})
+
+	t.Run("set values validation error", func(t *testing.T) {
+		tests := []struct {
+			value   string
+			wantErr string
+		}{
+			{value: "8080", wantErr: "failed to parse value '8080', should be in format NAME=PORT"},
+			{value: "HTTP=8080", wantErr: "invalid port name 'HTTP'"},
+			{value: "http-and-metrics=8080", wantErr: "invalid port name 'http-and-metrics'"},
+			{value: "http=port", wantErr: "invalid port number 'port', should be between 1 and 65535"},
+			{value: "http=70000", wantErr: "invalid port number '70000', should be between 1 and 65535"},
+			{value: "http=9090", wantErr: "duplicate port number: '9090' is provided multiple times"},
+			{value: "grpc=9000", wantErr: "duplicate port name: 'grpc' is provided multiple times"},
+		}
+
+		for _, tt := range tests {
+			ports := PortArray{Ports: []PortSpec{{Name: "grpc", Port: 9090}}}
+			require.ErrorContains(t, ports.Set(tt.value), tt.wantErr, tt.value)
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+const (
+	ColorRed    = "\033[31m"
+	ColorGreen  = "\033[32m"
+	ColorYellow = "\033[33m"
+	ColorCyan   = "\033[36m"
+	colorReset  = "\033[0m"
+)
+
+// IsColorTerminal returns true if the standard output is a terminal that supports colors
+func IsColorTerminal() bool {
+	return os.Getenv("NO_COLOR") == "" && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// Colorize wraps the text with the given color escape code
+func Colorize(color, text string) string {
+	return color + text + colorReset
+}
@@ -24,22 +24,34 @@ func (c *TemplateConfigurator[T]) Configure(cfgTmpl types.ActionConfig, overwrit
 }
 
 func (c *TemplateConfigurator[T]) configure(cfgTmpl types.ActionConfig, overwrites types.ActionConfigOverwrites) clierror.Error {
-	tmplBytes, err := yaml.Marshal(cfgTmpl)
-	if err != nil {
-		return clierror.Wrap(err, clierror.New("failed to marshal config template"))
-	}
-
-	configBytes, clierr := templateConfig(tmplBytes, overwrites)
+	configBytes, clierr := RenderConfig(cfgTmpl, overwrites)
 	if clierr != nil {
-		return clierror.WrapE(clierr, clierror.New("failed to template config"))
+		return clierr
 	}
 
 	out.Debugfln("Templated action config:\n%s\n", string(configBytes))
 
-	err = yaml.Unmarshal(configBytes, &c.Cfg)
+	// reset config to not keep values from the previous configuration (the same action can be used in many steps)
+	c.Cfg = *new(T)
+	err := yaml.Unmarshal(configBytes, &c.Cfg)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to configure action"))
 	}
 
 	return nil
 }
+
+// RenderConfig templates the action config using given overwrites and returns it in the YAML format
+func RenderConfig(cfgTmpl types.ActionConfig, overwrites types.ActionConfigOverwrites) ([]byte, clierror.Error) {
+	tmplBytes, err := yaml.Marshal(cfgTmpl)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to marshal config template"))
+	}
+
+	configBytes, clierr := templateConfig(tmplBytes, overwrites)
+	if clierr != nil {
+		return nil, clierror.WrapE(clierr, clierror.New("failed to template config"))
+	}
+
+	return configBytes, nil
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+// DiffObjects returns unified diff of objects in the YAML format
+// fields changed by the server on every write (like managedFields) are skipped
+// returns empty string if objects are equal
+func DiffObjects(before, after map[string]interface{}, colored bool) (string, error) {
+	beforeBytes, err := marshalForDiff(before)
+	if err != nil {
+		return "", err
+	}
+
+	afterBytes, err := marshalForDiff(after)
+	if err != nil {
+		return "", err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(string(beforeBytes)),
+		B:        splitLines(string(afterBytes)),
+		FromFile: "before",
+		ToFile:   "after",
+		Context:  3,
+	})
+	if err != nil || !colored {
+		return diff, err
+	}
+
+	return colorizeDiff(diff), nil
+}
+
+func marshalForDiff(obj map[string]interface{}) ([]byte, error) {
+	if obj == nil {
+		return []byte{}, nil
+	}
+
+	metadata, ok := obj["metadata"].(map[string]interface{})
+	if ok {
+		// copy metadata to not modify the given object
+		metadataCopy := map[string]interface{}{}
+		for k, v := range metadata {
+			if k != "managedFields" && k != "resourceVersion" {
+				metadataCopy[k] = v
+			}
+		}
+
+		objCopy := map[string]interface{}{}
+		for k, v := range obj {
+			objCopy[k] = v
+		}
+		objCopy["metadata"] = metadataCopy
+		obj = objCopy
+	}
+
+	return yaml.Marshal(obj)
+}
+
+// splitLines splits text into lines keeping new line characters
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		// skip empty element after the last new line
+		return lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+func colorizeDiff(diff string) string {
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = colorLine(ColorCyan, line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = colorLine(ColorRed, line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = colorLine(ColorGreen, line)
+		}
+	}
+
+	return strings.Join(lines, "")
+}
+
+func colorLine(color, line string) string {
+	content, hasNewLine := strings.CutSuffix(line, "\n")
+	if hasNewLine {
+		return Colorize(color, content) + "\n"
+	}
+
+	return Colorize(color, content)
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffObjects(t *testing.T) {
+	before := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "test",
+			"resourceVersion": "1",
+			"managedFields":   []interface{}{"field"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": 1,
+		},
+	}
+	after := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "test",
+			"resourceVersion": "2",
+		},
+		"spec": map[string]interface{}{
+			"replicas": 2,
+		},
+	}
+
+	t.Run("diff objects", func(t *testing.T) {
+		diff, err := DiffObjects(before, after, false)
+		require.NoError(t, err)
+		require.Equal(t, "--- before\n+++ after\n@@ -1,4 +1,4 @@\n metadata:\n     name: test\n spec:\n-    replicas: 1\n+    replicas: 2\n", diff)
+
+		// given objects are not modified
+		require.Contains(t, before["metadata"], "managedFields")
+	})
+
+	t.Run("colored diff", func(t *testing.T) {
+		diff, err := DiffObjects(before, after, true)
+		require.NoError(t, err)
+		require.Contains(t, diff, "\033[31m-    replicas: 1\033[0m\n")
+		require.Contains(t, diff, "\033[32m+    replicas: 2\033[0m\n")
+	})
+
+	t.Run("diff new object", func(t *testing.T) {
+		diff, err := DiffObjects(nil, map[string]interface{}{"kind": "Secret"}, false)
+		require.NoError(t, err)
+		require.Equal(t, "--- before\n+++ after\n@@ -0,0 +1 @@\n+kind: Secret\n", diff)
+	})
+
+	t.Run("no diff", func(t *testing.T) {
+		diff, err := DiffObjects(before, before, false)
+		require.NoError(t, err)
+		require.Empty(t, diff)
+	})
+}
@@ -0,0 +1,316 @@
+package actions
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	cmd_types "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/call"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type httpTargetConfig struct {
+	Namespace   string            `yaml:"namespace"`
+	Selector    map[string]string `yaml:"selector"`
+	ServiceName string            `yaml:"serviceName"`
+	Port        string            `yaml:"port"`
+	URL         string            `yaml:"url"`
+}
+
+type httpAuthConfig struct {
+	BearerToken string `yaml:"bearerToken"`
+	Username    string `yaml:"username"`
+	Password    string `yaml:"password"`
+}
+
+type httpRequestConfig struct {
+	Method     string            `yaml:"method"`
+	Path       string            `yaml:"path"`
+	Parameters map[string]string `yaml:"parameters"`
+	Headers    map[string]string `yaml:"headers"`
+	// raw string body or object sent as JSON
+	Body interface{}    `yaml:"body"`
+	Auth httpAuthConfig `yaml:"auth"`
+	// timeout of the request sent directly to the host
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type httpRequestActionConfig struct {
+	Request          httpRequestConfig `yaml:"request"`
+	Target           httpTargetConfig  `yaml:"target"`
+	OutputFormat     cmd_types.Format  `yaml:"output"`
+	OutputFile       string            `yaml:"outputFile"`
+	ResponsePath     string            `yaml:"responsePath"`
+	OutputParameters []outputParameter `yaml:"outputParameters"`
+}
+
+func (c *httpRequestActionConfig) validate() clierror.Error {
+	targets := 0
+	for _, target := range []bool{c.Target.URL != "", c.Target.ServiceName != "", c.Target.Selector != nil} {
+		if target {
+			targets++
+		}
+	}
+
+	if targets != 1 {
+		return clierror.New("exactly one of target url, serviceName or selector must be set")
+	}
+	if c.Target.URL == "" && c.Target.Namespace == "" {
+		return clierror.New("empty target namespace")
+	}
+	if c.Target.Selector != nil && c.Target.Port == "" {
+		return clierror.New("empty target Pod port")
+	}
+	if c.Request.Auth.BearerToken != "" && c.Request.Auth.Username != "" {
+		return clierror.New("bearerToken and username can't be used together")
+	}
+	if c.Request.Timeout < 0 {
+		return clierror.New("request timeout can't be negative")
+	}
+
+	return nil
+}
+
+type httpRequestAction struct {
+	common.TemplateConfigurator[httpRequestActionConfig]
+
+	kymaConfig *cmdcommon.KymaConfig
+	outputs    map[string]interface{}
+}
+
+func NewHTTPRequest(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &httpRequestAction{
+		kymaConfig: kymaConfig,
+	}
+}
+
+// Outputs returns the response parsed from JSON or the raw response if it's not a valid JSON
+func (a *httpRequestAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
+
+func (a *httpRequestAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	a.outputs = nil
+	clierr := a.Cfg.validate()
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("invalid action configuration"))
+	}
+
+	request, clierr := a.buildRequest()
+	if clierr != nil {
+		return clierr
+	}
+
+	caller, clierr := a.buildCaller()
+	if clierr != nil {
+		return clierr
+	}
+
+	bytesResp, clierr := caller.Do(request)
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("failed to call server"))
+	}
+	a.outputs = map[string]interface{}{
+		"response": parseResponse(bytesResp),
+	}
+
+	if a.Cfg.OutputFile != "" {
+		return saveResponse(a.Cfg.OutputFile, bytesResp)
+	}
+
+	output, err := a.formatOutput(bytesResp)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to format output"))
+	}
+
+	out.Msgln(output)
+	return nil
+}
+
+func parseResponse(bytesResp []byte) interface{} {
+	var response interface{}
+	err := json.Unmarshal(bytesResp, &response)
+	if err != nil {
+		return string(bytesResp)
+	}
+
+	return response
+}
+
+func (a *httpRequestAction) buildRequest() (call.Request, clierror.Error) {
+	request := call.Request{
+		Method:     a.Cfg.Request.Method,
+		Path:       a.Cfg.Request.Path,
+		Parameters: a.Cfg.Request.Parameters,
+		Headers:    map[string]string{},
+	}
+
+	switch body := a.Cfg.Request.Body.(type) {
+	case nil:
+	case string:
+		request.Body = []byte(body)
+	default:
+		// send structured body as JSON
+		bytesBody, err := json.Marshal(body)
+		if err != nil {
+			return request, clierror.Wrap(err, clierror.New("failed to marshal request body"))
+		}
+		request.Body = bytesBody
+		request.Headers["Content-Type"] = "application/json"
+	}
+
+	auth := a.Cfg.Request.Auth
+	if auth.BearerToken != "" {
+		request.Headers["Authorization"] = "Bearer " + auth.BearerToken
+	}
+	if auth.Username != "" {
+		request.Headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(auth.Username+":"+auth.Password))
+	}
+
+	// headers from the config overwrite the generated ones
+	for k, v := range a.Cfg.Request.Headers {
+		request.Headers[k] = v
+	}
+
+	return request, nil
+}
+
+func (a *httpRequestAction) buildCaller() (call.Caller, clierror.Error) {
+	if a.Cfg.Target.URL != "" {
+		return call.NewHostCaller(a.Cfg.Target.URL, a.Cfg.Request.Timeout), nil
+	}
+
+	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	if a.Cfg.Target.ServiceName != "" {
+		return call.NewServiceCaller(
+			a.kymaConfig.Ctx,
+			client,
+			a.Cfg.Target.Namespace,
+			a.Cfg.Target.ServiceName,
+			a.Cfg.Target.Port,
+		), nil
+	}
+
+	return call.NewPodCaller(
+		a.kymaConfig.Ctx,
+		client,
+		a.Cfg.Target.Namespace,
+		a.Cfg.Target.Selector,
+		a.Cfg.Target.Port,
+	), nil
+}
+
+// formatOutput prints raw response or renders it in the same way as the resource_get action does
+func (a *httpRequestAction) formatOutput(bytesResp []byte) (string, error) {
+	if a.Cfg.OutputFormat == "" && len(a.Cfg.OutputParameters) == 0 {
+		return string(bytesResp), nil
+	}
+
+	var data interface{}
+	err := json.Unmarshal(bytesResp, &data)
+	if err != nil {
+		return "", fmt.Errorf("response is not a valid JSON: %w", err)
+	}
+
+	if len(a.Cfg.OutputParameters) == 0 {
+		return marshalOutput(a.Cfg.OutputFormat, data)
+	}
+
+	items, err := selectResponseItems(data, a.Cfg.ResponsePath)
+	if err != nil {
+		return "", err
+	}
+
+	if a.Cfg.OutputFormat != "" {
+		tableInfo := newTableInfo([]interface{}{}, []FieldConverter{}, a.Cfg.OutputParameters, false)
+		return marshalOutput(a.Cfg.OutputFormat, convertResourcesToParameters(items, tableInfo))
+	}
+
+	tableInfo := newTableInfo([]interface{}{}, []FieldConverter{}, a.Cfg.OutputParameters, common.IsColorTerminal())
+
+	rows := [][]interface{}{}
+	for _, item := range items {
+		rows = append(rows, tableInfo.RowConverter(item))
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	render.Table(buf, tableInfo.Headers, rows)
+	return buf.String(), nil
+}
+
+// selectResponseItems returns all objects selected from the response by the jq path
+func selectResponseItems(data interface{}, path string) ([]unstructured.Unstructured, error) {
+	if path == "" {
+		path = "."
+	}
+
+	query, err := gojq.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response path '%s': %w", path, err)
+	}
+
+	items := []unstructured.Unstructured{}
+	iter := query.Run(data)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		if err, isError := value.(error); isError {
+			return nil, fmt.Errorf("failed to select items from response: %w", err)
+		}
+
+		obj, isObject := value.(map[string]interface{})
+		if !isObject {
+			return nil, fmt.Errorf("response item '%v' is not an object, use the responsePath to select objects", value)
+		}
+
+		items = append(items, unstructured.Unstructured{Object: obj})
+	}
+
+	return items, nil
+}
+
+func marshalOutput(format cmd_types.Format, data interface{}) (string, error) {
+	if format == cmd_types.YAMLFormat {
+		obj, err := yaml.Marshal(data)
+		return string(obj), err
+	}
+
+	obj, err := json.MarshalIndent(data, "", "  ")
+	return string(obj), err
+}
+
+func saveResponse(outputFile string, data []byte) clierror.Error {
+	err := os.MkdirAll(filepath.Dir(outputFile), 0755)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to create directory for file %s", outputFile)))
+	}
+
+	err = os.WriteFile(outputFile, data, 0644)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to write file %s", outputFile)))
+	}
+
+	out.Msgfln("Response saved to %s", outputFile)
+	return nil
+}
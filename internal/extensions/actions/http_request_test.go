@@ -0,0 +1,267 @@
+package actions
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions/call"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_httpRequestAction_Run(t *testing.T) {
+	t.Run("send request to the host and save response", func(t *testing.T) {
+		var gotRequest *http.Request
+		var gotBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotRequest = r
+			gotBody, _ = io.ReadAll(r.Body)
+			_, _ = w.Write([]byte(`{"status":"created"}`))
+		}))
+		defer server.Close()
+
+		outputFile := filepath.Join(t.TempDir(), "responses", "order.json")
+		action := NewHTTPRequest(&cmdcommon.KymaConfig{})
+		clierr := action.Configure(map[string]interface{}{
+			"request": map[string]interface{}{
+				"method":     "POST",
+				"path":       "/orders",
+				"parameters": map[string]interface{}{"limit": "10"},
+				"body":       map[string]interface{}{"id": "order-1"},
+			},
+			"target": map[string]interface{}{
+				"url": server.URL,
+			},
+			"outputFile": outputFile,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, http.MethodPost, gotRequest.Method)
+		require.Equal(t, "/orders", gotRequest.URL.Path)
+		require.Equal(t, "10", gotRequest.URL.Query().Get("limit"))
+		require.Equal(t, "application/json", gotRequest.Header.Get("Content-Type"))
+		require.Equal(t, `{"id":"order-1"}`, string(gotBody))
+		require.Equal(t, map[string]interface{}{
+			"response": map[string]interface{}{"status": "created"},
+		}, action.(*httpRequestAction).Outputs())
+
+		data, err := os.ReadFile(outputFile)
+		require.NoError(t, err)
+		require.Equal(t, `{"status":"created"}`, string(data))
+	})
+
+	t.Run("request timeout", func(t *testing.T) {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			<-done
+		}))
+		defer server.Close()
+		defer close(done)
+
+		action := NewHTTPRequest(&cmdcommon.KymaConfig{})
+		clierr := action.Configure(map[string]interface{}{
+			"request": map[string]interface{}{
+				"timeout": "100ms",
+			},
+			"target": map[string]interface{}{
+				"url": server.URL,
+			},
+		}, nil)
+		require.Nil(t, clierr)
+		require.Equal(t, 100*time.Millisecond, action.(*httpRequestAction).Cfg.Request.Timeout)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to send request to the host")
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		action := NewHTTPRequest(&cmdcommon.KymaConfig{})
+		clierr := action.Configure(map[string]interface{}{
+			"target": map[string]interface{}{
+				"url":         "https://my-app.local",
+				"serviceName": "my-app",
+				"namespace":   "default",
+			},
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "exactly one of target url, serviceName or selector must be set")
+	})
+}
+
+func Test_httpRequestAction_buildRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request httpRequestConfig
+		want    call.Request
+	}{
+		{
+			name: "raw body with bearer token",
+			request: httpRequestConfig{
+				Method: "PUT",
+				Path:   "/items/1",
+				Body:   "plain text",
+				Auth:   httpAuthConfig{BearerToken: "token"},
+			},
+			want: call.Request{
+				Method:  "PUT",
+				Path:    "/items/1",
+				Body:    []byte("plain text"),
+				Headers: map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+		{
+			name: "structured body with basic auth",
+			request: httpRequestConfig{
+				Method:     "POST",
+				Path:       "/items",
+				Parameters: map[string]string{"dryRun": "true"},
+				Body:       map[string]interface{}{"name": "item"},
+				Auth:       httpAuthConfig{Username: "user", Password: "pass"},
+			},
+			want: call.Request{
+				Method:     "POST",
+				Path:       "/items",
+				Parameters: map[string]string{"dryRun": "true"},
+				Body:       []byte(`{"name":"item"}`),
+				Headers: map[string]string{
+					"Content-Type":  "application/json",
+					"Authorization": "Basic dXNlcjpwYXNz",
+				},
+			},
+		},
+		{
+			name: "headers overwrite generated ones",
+			request: httpRequestConfig{
+				Body: []interface{}{"a", "b"},
+				Headers: map[string]string{
+					"Content-Type": "application/merge-patch+json",
+					"X-Request-Id": "1",
+				},
+				Auth: httpAuthConfig{BearerToken: "token"},
+			},
+			want: call.Request{
+				Body: []byte(`["a","b"]`),
+				Headers: map[string]string{
+					"Content-Type":  "application/merge-patch+json",
+					"X-Request-Id":  "1",
+					"Authorization": "Bearer token",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := &httpRequestAction{}
+			action.Cfg.Request = tt.request
+
+			request, clierr := action.buildRequest()
+			require.Nil(t, clierr)
+			require.Equal(t, tt.want, request)
+		})
+	}
+}
+
+func Test_httpRequestAction_formatOutput(t *testing.T) {
+	response := []byte(`{"items":[{"name":"app-a","replicas":1},{"name":"app-b","replicas":3}]}`)
+	outputParameters := []outputParameter{
+		{Name: "name", ResourcePath: ".name"},
+		{Name: "replicas", ResourcePath: ".replicas"},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      httpRequestActionConfig
+		response []byte
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "raw response",
+			response: []byte("plain text"),
+			want:     "plain text",
+		},
+		{
+			name:     "yaml response",
+			cfg:      httpRequestActionConfig{OutputFormat: "yaml"},
+			response: []byte(`{"name":"app-a"}`),
+			want:     "name: app-a\n",
+		},
+		{
+			name: "table of selected items",
+			cfg: httpRequestActionConfig{
+				ResponsePath:     ".items[]",
+				OutputParameters: outputParameters,
+			},
+			response: response,
+			want: "NAME    REPLICAS   \n" +
+				"app-a   1          \n" +
+				"app-b   3          \n",
+		},
+		{
+			name: "json parameters of selected items",
+			cfg: httpRequestActionConfig{
+				OutputFormat:     "json",
+				ResponsePath:     ".items[0]",
+				OutputParameters: outputParameters,
+			},
+			response: response,
+			want:     "[\n  {\n    \"name\": \"app-a\",\n    \"replicas\": \"1\"\n  }\n]",
+		},
+		{
+			name:     "not a json response",
+			cfg:      httpRequestActionConfig{OutputFormat: "json"},
+			response: []byte("plain text"),
+			wantErr:  "response is not a valid JSON",
+		},
+		{
+			name: "selected item is not an object",
+			cfg: httpRequestActionConfig{
+				ResponsePath:     ".items[].name",
+				OutputParameters: outputParameters,
+			},
+			response: response,
+			wantErr:  "response item 'app-a' is not an object, use the responsePath to select objects",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := &httpRequestAction{}
+			action.Cfg = tt.cfg
+
+			output, err := action.formatOutput(tt.response)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
+
+func Test_saveResponse(t *testing.T) {
+	outputFile := filepath.Join(t.TempDir(), "nested", "dir", "response.txt")
+
+	clierr := saveResponse(outputFile, []byte("response"))
+	require.Nil(t, clierr)
+
+	data, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	require.Equal(t, "response", string(data))
+
+	info, err := os.Stat(outputFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0644), info.Mode().Perm())
+}
@@ -0,0 +1,58 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// annotation used by kubectl to select the container when it's not specified
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+type podTargetConfig struct {
+	Namespace string            `yaml:"namespace"`
+	Selector  map[string]string `yaml:"selector"`
+	Container string            `yaml:"container"`
+}
+
+func (c *podTargetConfig) validate() clierror.Error {
+	if c.Namespace == "" {
+		return clierror.New("empty target namespace")
+	}
+	if len(c.Selector) == 0 {
+		return clierror.New("empty target Pod selector")
+	}
+
+	return nil
+}
+
+// getTargetPod returns ready Pod selected by the config and the name of the target container
+func getTargetPod(ctx context.Context, client kubernetes.Interface, target podTargetConfig) (*corev1.Pod, string, clierror.Error) {
+	pod, err := resources.GetPodForSelector(ctx, client, target.Namespace, target.Selector)
+	if err != nil {
+		return nil, "", clierror.Wrap(err, clierror.New("failed to find target Pod",
+			"make sure the workload is running and the selector matches its Pods"))
+	}
+
+	container := target.Container
+	if container == "" {
+		container = defaultContainerName(pod)
+	}
+
+	return pod, container, nil
+}
+
+func defaultContainerName(pod *corev1.Pod) string {
+	if name := pod.Annotations[defaultContainerAnnotation]; name != "" {
+		return name
+	}
+
+	if len(pod.Spec.Containers) == 0 {
+		return ""
+	}
+
+	return pod.Spec.Containers[0].Name
+}
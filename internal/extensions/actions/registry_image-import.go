@@ -30,6 +30,7 @@ type registryImageImportAction struct {
 	common.TemplateConfigurator[registryImageImportActionConfig]
 
 	kymaConfig *cmdcommon.KymaConfig
+	outputs    map[string]interface{}
 }
 
 func NewRegistryImageImport(kymaConfig *cmdcommon.KymaConfig) types.Action {
@@ -38,7 +39,13 @@ func NewRegistryImageImport(kymaConfig *cmdcommon.KymaConfig) types.Action {
 	}
 }
 
+// Outputs returns the imported image name and the pull secret name
+func (a *registryImageImportAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
+
 func (a *registryImageImportAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	a.outputs = nil
 	err := a.Cfg.validate()
 	if err != nil {
 		return err
@@ -85,6 +92,11 @@ func (a *registryImageImportAction) Run(cmd *cobra.Command, _ []string) clierror
 	}
 
 	pullImageName := fmt.Sprintf("%s/%s", registryConfig.SecretData.PullRegAddr, pushedImage)
+	a.outputs = map[string]interface{}{
+		"image":      pullImageName,
+		"secretName": registryConfig.SecretName,
+	}
+
 	out.Msgln("\nSuccessfully imported image")
 	out.Msgfln("Use it as '%s' and use the %s secret.", pullImageName, registryConfig.SecretName)
 	out.Msgfln("\nExample usage:\nkubectl run my-pod --image=%s --overrides='{ \"spec\": { \"imagePullSecrets\": [ { \"name\": \"%s\" } ] } }'", pullImageName, registryConfig.SecretName)
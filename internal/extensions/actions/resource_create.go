@@ -27,6 +27,7 @@ type resourceCreateAction struct {
 	common.TemplateConfigurator[resourceCreateActionConfig]
 
 	kymaConfig *cmdcommon.KymaConfig
+	outputs    map[string]interface{}
 }
 
 func NewResourceCreate(kymaConfig *cmdcommon.KymaConfig) types.Action {
@@ -35,7 +36,13 @@ func NewResourceCreate(kymaConfig *cmdcommon.KymaConfig) types.Action {
 	}
 }
 
+// Outputs returns the applied resource
+func (a *resourceCreateAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
+
 func (a *resourceCreateAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	a.outputs = nil
 	u := &unstructured.Unstructured{
 		Object: a.Cfg.Resource,
 	}
@@ -53,6 +60,9 @@ func (a *resourceCreateAction) Run(cmd *cobra.Command, _ []string) clierror.Erro
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to create resource"))
 	}
+	a.outputs = map[string]interface{}{
+		"resource": u.Object,
+	}
 
 	output, err := a.formatOutput(u)
 	if err != nil {
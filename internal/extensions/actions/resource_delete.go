@@ -1,33 +1,66 @@
 package actions
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
 	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 type resourceDeleteActionConfig struct {
-	DryRun   bool                   `yaml:"dryRun"`
-	Resource map[string]interface{} `yaml:"resource"`
+	DryRun bool `yaml:"dryRun"`
+	// skip confirmation of deleting resources selected by selectors
+	AutoApprove bool `yaml:"autoApprove"`
+	// allow deleting resources selected by selectors from all namespaces
+	FromAllNamespaces bool                   `yaml:"fromAllNamespaces"`
+	Resource          map[string]interface{} `yaml:"resource"`
+	resourceSelector  `yaml:",inline"`
+}
+
+func (c *resourceDeleteActionConfig) validate() clierror.Error {
+	u := unstructured.Unstructured{Object: c.Resource}
+	if u.GetName() == "" && c.empty() {
+		return clierror.New("empty resource name", "set the resource name or the labelSelector or the fieldSelector")
+	}
+
+	if u.GetName() != "" && !c.empty() {
+		return clierror.New("resource name can't be used together with the labelSelector or the fieldSelector")
+	}
+
+	return c.resourceSelector.validate()
 }
 
 type resourceDeleteAction struct {
 	common.TemplateConfigurator[resourceDeleteActionConfig]
 
 	kymaConfig *cmdcommon.KymaConfig
+	// asks the user to confirm deleting many resources
+	confirm func(message string) (bool, error)
 }
 
 func NewResourceDelete(kymaConfig *cmdcommon.KymaConfig) types.Action {
 	return &resourceDeleteAction{
 		kymaConfig: kymaConfig,
+		confirm: func(message string) (bool, error) {
+			return prompt.NewBool(message, false).Prompt()
+		},
 	}
 }
 
 func (a *resourceDeleteAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	clierr := a.Cfg.validate()
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("invalid action configuration"))
+	}
+
 	u := &unstructured.Unstructured{
 		Object: a.Cfg.Resource,
 	}
@@ -37,17 +70,100 @@ func (a *resourceDeleteAction) Run(cmd *cobra.Command, _ []string) clierror.Erro
 		return clierr
 	}
 
+	if !a.Cfg.empty() {
+		return a.deleteSelected(client.RootlessDynamic(), u)
+	}
+
 	err := client.RootlessDynamic().Remove(a.kymaConfig.Ctx, u, a.Cfg.DryRun)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to delete resource"))
 	}
 
-	messageSuffix := ""
-	if a.Cfg.DryRun {
-		messageSuffix = " (dry run)"
+	out.Msgfln("resource %s deleted%s", u.GetName(), a.dryRunSuffix())
+
+	return nil
+}
+
+// deleteSelected deletes all resources selected by selectors after the user confirms it
+func (a *resourceDeleteAction) deleteSelected(client rootlessdynamic.Interface, u *unstructured.Unstructured) clierror.Error {
+	listOptions := a.Cfg.listOptions("", a.Cfg.FromAllNamespaces)
+	list, err := client.List(a.kymaConfig.Ctx, u, &listOptions)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to list resources to delete"))
 	}
 
-	out.Msgfln("resource %s deleted%s", u.GetName(), messageSuffix)
+	resources, clierr := a.selectNamespacedResources(u.GetNamespace(), list)
+	if clierr != nil {
+		return clierr
+	}
+
+	if len(resources) == 0 {
+		out.Msgln("no resources found")
+		return nil
+	}
+
+	out.Msgfln("resources to delete%s:", a.dryRunSuffix())
+	for _, resource := range resources {
+		out.Msgfln("  %s", resourceDisplayName(resource))
+	}
+
+	if !a.Cfg.DryRun && !a.Cfg.AutoApprove {
+		confirmed, err := a.confirm(fmt.Sprintf("\nDo you want to delete %d resources?", len(resources)))
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to prompt for user input",
+				"if error repeats, consider running the command with the auto-approve flag if the command provides it"))
+		}
+
+		if !confirmed {
+			return nil
+		}
+	}
+
+	for _, resource := range resources {
+		err = client.Remove(a.kymaConfig.Ctx, &resource, a.Cfg.DryRun)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to delete resource %s", resourceDisplayName(resource))))
+		}
+
+		out.Msgfln("resource %s deleted%s", resource.GetName(), a.dryRunSuffix())
+	}
 
 	return nil
 }
+
+// selectNamespacedResources returns listed resources from the given namespace
+// cluster-scoped resources are returned as they are, but namespaced ones require the namespace or the fromAllNamespaces field
+func (a *resourceDeleteAction) selectNamespacedResources(namespace string, list *unstructured.UnstructuredList) ([]unstructured.Unstructured, clierror.Error) {
+	resources := []unstructured.Unstructured{}
+	for _, resource := range list.Items {
+		if a.Cfg.FromAllNamespaces || resource.GetNamespace() == namespace {
+			resources = append(resources, resource)
+			continue
+		}
+
+		if namespace == "" {
+			return nil, clierror.New("empty resource namespace",
+				"set the resource namespace or the fromAllNamespaces field to delete selected resources from all namespaces")
+		}
+	}
+
+	return resources, nil
+}
+
+func (a *resourceDeleteAction) dryRunSuffix() string {
+	if a.Cfg.DryRun {
+		return " (dry run)"
+	}
+
+	return ""
+}
+
+// resourceDisplayName returns the resource name in the kind/namespace/name format
+func resourceDisplayName(resource unstructured.Unstructured) string {
+	elems := []string{strings.ToLower(resource.GetKind())}
+	if resource.GetNamespace() != "" {
+		elems = append(elems, resource.GetNamespace())
+	}
+
+	return strings.Join(append(elems, resource.GetName()), "/")
+}
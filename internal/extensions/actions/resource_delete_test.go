@@ -0,0 +1,241 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_resourceDeleteAction_Run(t *testing.T) {
+	t.Run("delete resource by name", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{}
+		action := fixResourceDeleteAction(rootlessDynamic, nil)
+
+		clierr := action.Configure(map[string]interface{}{
+			"resource": fixDeleteResource("app-a").Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []unstructured.Unstructured{fixDeleteResource("app-a")}, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("delete resources selected by labels after confirmation", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{fixDeleteResource("app-a"), fixDeleteResource("app-b")},
+			},
+		}
+		confirmMessages := []string{}
+		action := fixResourceDeleteAction(rootlessDynamic, func(message string) (bool, error) {
+			confirmMessages = append(confirmMessages, message)
+			return true, nil
+		})
+
+		clierr := action.Configure(map[string]interface{}{
+			"labelSelector": "app=test",
+			"fieldSelector": "status.phase=Running",
+			"resource":      fixDeleteResource("").Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []string{"\nDo you want to delete 2 resources?"}, confirmMessages)
+		require.Equal(t, []unstructured.Unstructured{fixDeleteResource("app-a"), fixDeleteResource("app-b")}, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("skip deleting resources if not confirmed", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{fixDeleteResource("app-a")},
+			},
+		}
+		action := fixResourceDeleteAction(rootlessDynamic, func(string) (bool, error) {
+			return false, nil
+		})
+
+		clierr := action.Configure(map[string]interface{}{
+			"labelSelector": "app=test",
+			"resource":      fixDeleteResource("").Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Empty(t, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("dry run deleting selected resources without confirmation", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{fixDeleteResource("app-a")},
+			},
+		}
+		action := fixResourceDeleteAction(rootlessDynamic, func(string) (bool, error) {
+			require.Fail(t, "unexpected confirmation")
+			return false, nil
+		})
+
+		clierr := action.Configure(map[string]interface{}{
+			"dryRun":        true,
+			"labelSelector": "app=test",
+			"resource":      fixDeleteResource("").Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []unstructured.Unstructured{fixDeleteResource("app-a")}, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("delete selected resources only from the resource namespace", func(t *testing.T) {
+		other := fixDeleteResource("app-b")
+		other.SetNamespace("other")
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{fixDeleteResource("app-a"), other},
+			},
+		}
+		action := fixResourceDeleteAction(rootlessDynamic, nil)
+
+		clierr := action.Configure(map[string]interface{}{
+			"autoApprove":   true,
+			"labelSelector": "app=test",
+			"resource":      fixDeleteResource("").Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []rootlessdynamic.ListOptions{{LabelSelector: "app=test"}}, rootlessDynamic.ListOpts)
+		require.Equal(t, []unstructured.Unstructured{fixDeleteResource("app-a")}, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("refuse deleting namespaced resources selected without namespace", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{fixDeleteResource("app-a")},
+			},
+		}
+		action := fixResourceDeleteAction(rootlessDynamic, nil)
+
+		resource := fixDeleteResource("")
+		resource.SetNamespace("")
+		clierr := action.Configure(map[string]interface{}{
+			"autoApprove":   true,
+			"labelSelector": "app=test",
+			"resource":      resource.Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Equal(t, clierror.New("empty resource namespace",
+			"set the resource namespace or the fromAllNamespaces field to delete selected resources from all namespaces"), clierr)
+		require.Empty(t, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("delete selected resources from all namespaces", func(t *testing.T) {
+		other := fixDeleteResource("app-b")
+		other.SetNamespace("other")
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{fixDeleteResource("app-a"), other},
+			},
+		}
+		action := fixResourceDeleteAction(rootlessDynamic, nil)
+
+		resource := fixDeleteResource("")
+		resource.SetNamespace("")
+		clierr := action.Configure(map[string]interface{}{
+			"autoApprove":       true,
+			"fromAllNamespaces": true,
+			"labelSelector":     "app=test",
+			"resource":          resource.Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []rootlessdynamic.ListOptions{{AllNamespaces: true, LabelSelector: "app=test"}}, rootlessDynamic.ListOpts)
+		require.Equal(t, []unstructured.Unstructured{fixDeleteResource("app-a"), other}, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("wrong configuration", func(t *testing.T) {
+		tests := []struct {
+			config map[string]interface{}
+			want   clierror.Error
+		}{
+			{
+				config: map[string]interface{}{
+					"resource": fixDeleteResource("").Object,
+				},
+				want: clierror.New("empty resource name", "set the resource name or the labelSelector or the fieldSelector"),
+			},
+			{
+				config: map[string]interface{}{
+					"labelSelector": "app=test",
+					"resource":      fixDeleteResource("app-a").Object,
+				},
+				want: clierror.New("resource name can't be used together with the labelSelector or the fieldSelector"),
+			},
+		}
+
+		for _, tt := range tests {
+			action := fixResourceDeleteAction(&kubefake.RootlessDynamicClient{}, nil)
+			require.Nil(t, action.Configure(tt.config, nil))
+
+			clierr := action.Run(&cobra.Command{}, []string{})
+			require.Equal(t, clierror.WrapE(tt.want, clierror.New("invalid action configuration")), clierr)
+		}
+	})
+}
+
+func Test_resourceSelector_listOptions(t *testing.T) {
+	selector := resourceSelector{
+		LabelSelector: "app=test",
+		FieldSelector: "status.phase=Running",
+	}
+
+	require.Equal(t, rootlessdynamic.ListOptions{
+		AllNamespaces: true,
+		FieldSelector: "metadata.name==app-a,status.phase=Running",
+		LabelSelector: "app=test",
+	}, selector.listOptions("app-a", true))
+
+	require.Nil(t, selector.validate())
+	require.NotNil(t, (&resourceSelector{LabelSelector: "app in (a"}).validate())
+	require.NotNil(t, (&resourceSelector{FieldSelector: "a"}).validate())
+}
+
+func fixResourceDeleteAction(rootlessDynamic *kubefake.RootlessDynamicClient, confirm func(string) (bool, error)) *resourceDeleteAction {
+	action := fixResourcePatchAction(NewResourceDelete, rootlessDynamic).(*resourceDeleteAction)
+	if confirm != nil {
+		action.confirm = confirm
+	}
+
+	return action
+}
+
+func fixDeleteResource(name string) unstructured.Unstructured {
+	metadata := map[string]interface{}{
+		"namespace": "default",
+	}
+	if name != "" {
+		metadata["name"] = name
+	}
+
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   metadata,
+		},
+	}
+}
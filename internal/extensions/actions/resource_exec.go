@@ -0,0 +1,115 @@
+package actions
+
+import (
+	"os"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+type resourceExecActionConfig struct {
+	Target  podTargetConfig `yaml:"target"`
+	Command []string        `yaml:"command"`
+	Stdin   bool            `yaml:"stdin"`
+	TTY     bool            `yaml:"tty"`
+}
+
+func (c *resourceExecActionConfig) validate() clierror.Error {
+	clierr := c.Target.validate()
+	if clierr != nil {
+		return clierr
+	}
+
+	if len(c.Command) == 0 {
+		return clierror.New("empty command")
+	}
+
+	return nil
+}
+
+type resourceExecAction struct {
+	common.TemplateConfigurator[resourceExecActionConfig]
+
+	kymaConfig *cmdcommon.KymaConfig
+}
+
+func NewResourceExec(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &resourceExecAction{
+		kymaConfig: kymaConfig,
+	}
+}
+
+func (a *resourceExecAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	clierr := a.Cfg.validate()
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("invalid action configuration"))
+	}
+
+	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	pod, container, clierr := getTargetPod(a.kymaConfig.Ctx, client.Static(), a.Cfg.Target)
+	if clierr != nil {
+		return clierr
+	}
+
+	// tty is possible only if the input is a terminal
+	tty := a.Cfg.TTY && a.Cfg.Stdin && term.IsTerminal(int(os.Stdin.Fd()))
+
+	req := client.Static().CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   a.Cfg.Command,
+			Stdin:     a.Cfg.Stdin,
+			Stdout:    true,
+			Stderr:    !tty,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+
+	out.Debugfln("Running command in the %s container of the %s/%s Pod", container, pod.Namespace, pod.Name)
+
+	executor, err := remotecommand.NewSPDYExecutor(client.RestConfig(), "POST", req.URL())
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to create executor"))
+	}
+
+	streamOpts := remotecommand.StreamOptions{
+		Stdout: cmd.OutOrStdout(),
+		Stderr: cmd.ErrOrStderr(),
+		Tty:    tty,
+	}
+	if a.Cfg.Stdin {
+		streamOpts.Stdin = cmd.InOrStdin()
+	}
+	if tty {
+		// stderr is merged with stdout in the tty mode
+		streamOpts.Stderr = nil
+
+		state, err := term.MakeRaw(int(os.Stdin.Fd()))
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to set terminal in the raw mode"))
+		}
+		defer func() { _ = term.Restore(int(os.Stdin.Fd()), state) }()
+	}
+
+	err = executor.StreamWithContext(a.kymaConfig.Ctx, streamOpts)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to run command in the Pod"))
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_resourceExecAction_Run(t *testing.T) {
+	t.Run("empty command", func(t *testing.T) {
+		action := NewResourceExec(&cmdcommon.KymaConfig{})
+
+		clierr := action.Configure(map[string]interface{}{
+			"target": map[string]interface{}{
+				"namespace": "default",
+				"selector": map[string]interface{}{
+					"app": "test",
+				},
+			},
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "empty command")
+	})
+
+	t.Run("empty selector", func(t *testing.T) {
+		action := NewResourceExec(&cmdcommon.KymaConfig{})
+
+		clierr := action.Configure(map[string]interface{}{
+			"target": map[string]interface{}{
+				"namespace": "default",
+			},
+			"command": []interface{}{"ls"},
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "empty target Pod selector")
+	})
+
+	t.Run("no ready Pod", func(t *testing.T) {
+		pod := fixTargetPod()
+		pod.Status.Conditions = nil
+		action := NewResourceExec(&cmdcommon.KymaConfig{
+			Ctx: context.Background(),
+			KubeClientConfig: &fakeKubeClientConfig{
+				kubeClient: &kubefake.KubeClient{
+					TestKubernetesInterface: k8sfake.NewClientset(pod),
+				},
+			},
+		})
+
+		clierr := action.Configure(map[string]interface{}{
+			"target": map[string]interface{}{
+				"namespace": "default",
+				"selector": map[string]interface{}{
+					"app": "test",
+				},
+			},
+			"command": []interface{}{"ls"},
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to find target Pod")
+	})
+}
@@ -2,11 +2,9 @@ package actions
 
 import (
 	"bytes"
-	"cmp"
 	"encoding/json"
 	"fmt"
 	"io"
-	"slices"
 
 	"github.com/itchyny/gojq"
 	"github.com/kyma-project/cli.v3/internal/clierror"
@@ -14,7 +12,6 @@ import (
 	cmd_types "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
-	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/kyma-project/cli.v3/internal/render"
 	"github.com/spf13/cobra"
@@ -23,15 +20,21 @@ import (
 )
 
 type resourceGetActionConfig struct {
-	OutputFormat      cmd_types.Format       `yaml:"output"`
+	OutputFormat      getOutput              `yaml:"output"`
 	FromAllNamespaces bool                   `yaml:"fromAllNamespaces"`
 	Resource          map[string]interface{} `yaml:"resource"`
 	OutputParameters  []outputParameter      `yaml:"outputParameters"`
+	SortBy            string                 `yaml:"sortBy"`
+	Watch             bool                   `yaml:"watch"`
+	resourceSelector  `yaml:",inline"`
 }
 
 type outputParameter struct {
-	Name         string `yaml:"name"`
-	ResourcePath string `yaml:"resourcePath"`
+	Name         string              `yaml:"name"`
+	ResourcePath string              `yaml:"resourcePath"`
+	Type         outputParameterType `yaml:"type"`
+	Wide         bool                `yaml:"wide"`
+	DefaultSort  sortOrder           `yaml:"defaultSort"`
 }
 
 type resourceGetAction struct {
@@ -51,25 +54,28 @@ func (a *resourceGetAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
 		Object: a.Cfg.Resource,
 	}
 
+	clierr := a.Cfg.resourceSelector.validate()
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("invalid action configuration"))
+	}
+
 	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
 	if clierr != nil {
 		return clierr
 	}
 
-	nameSelector := ""
-	if u.GetName() != "" {
-		// set name field selector to get only one resource
-		nameSelector = fmt.Sprintf("metadata.name==%s", u.GetName())
-	}
+	listOptions := a.Cfg.listOptions(u.GetName(), a.Cfg.FromAllNamespaces)
 
-	resources, err := client.RootlessDynamic().List(a.kymaConfig.Ctx, u, &rootlessdynamic.ListOptions{
-		AllNamespaces: a.Cfg.FromAllNamespaces,
-		FieldSelector: nameSelector,
-	})
+	resources, err := client.RootlessDynamic().List(a.kymaConfig.Ctx, u, &listOptions)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to get resource"))
 	}
 
+	if a.Cfg.Watch {
+		return newResourcesWatcher(a, client.RootlessDynamic(), out.Default).
+			watch(a.kymaConfig.Ctx, u, listOptions, resources)
+	}
+
 	output, err := a.formatOutput(resources)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to format output"))
@@ -80,25 +86,59 @@ func (a *resourceGetAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
 }
 
 func (a *resourceGetAction) formatOutput(resources *unstructured.UnstructuredList) (string, error) {
-	tableInfo := buildTableInfo(&a.Cfg)
-	outputParameters := convertResourcesToParameters(resources.Items, tableInfo)
+	err := sortResources(resources.Items, &a.Cfg)
+	if err != nil {
+		return "", err
+	}
 
-	if a.Cfg.OutputFormat == cmd_types.JSONFormat {
-		obj, err := json.MarshalIndent(outputParameters, "", "  ")
-		return string(obj), err
+	if a.Cfg.OutputFormat.jsonPath != "" {
+		return renderJSONPath(a.Cfg.OutputFormat.jsonPath, a.jsonPathData(resources))
 	}
 
-	if a.Cfg.OutputFormat == cmd_types.YAMLFormat {
+	if a.Cfg.OutputFormat.format != cmd_types.DefaultFormat {
+		// structured output contains all output parameters without colors
+		outputParameters := convertResourcesToParameters(resources.Items, buildTableInfo(&a.Cfg, true, false))
+
+		if a.Cfg.OutputFormat.format == cmd_types.JSONFormat {
+			obj, err := json.MarshalIndent(outputParameters, "", "  ")
+			return string(obj), err
+		}
+
 		obj, err := yaml.Marshal(outputParameters)
 		return string(obj), err
 	}
 
 	buf := bytes.NewBuffer([]byte{})
-	renderTable(buf, resources.Items, tableInfo)
+	renderTable(buf, resources.Items, buildTableInfo(&a.Cfg, a.Cfg.OutputFormat.wide, common.IsColorTerminal()))
 	return buf.String(), nil
 }
 
-func buildTableInfo(cfg *resourceGetActionConfig) TableInfo {
+// jsonPathData returns the only resource if it's requested by name or list of resources otherwise
+func (a *resourceGetAction) jsonPathData(resources *unstructured.UnstructuredList) interface{} {
+	u := unstructured.Unstructured{Object: a.Cfg.Resource}
+	if u.GetName() != "" && len(resources.Items) == 1 {
+		return resources.Items[0].Object
+	}
+
+	items := make([]interface{}, len(resources.Items))
+	for i := range resources.Items {
+		items[i] = resources.Items[i].Object
+	}
+
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	}
+}
+
+// buildTableInfo builds table info with default columns and output parameters or with custom columns only
+// wide output parameters are included only if the wide arg is true
+func buildTableInfo(cfg *resourceGetActionConfig, wide, colored bool) TableInfo {
+	if len(cfg.OutputFormat.customColumns) != 0 {
+		return newTableInfo([]interface{}{}, []FieldConverter{}, cfg.OutputFormat.customColumns, colored)
+	}
+
 	Headers := []interface{}{}
 	fieldConverters := []FieldConverter{}
 
@@ -110,9 +150,21 @@ func buildTableInfo(cfg *resourceGetActionConfig) TableInfo {
 	Headers = append(Headers, "name")
 	fieldConverters = append(fieldConverters, genericFieldConverter(".metadata.name"))
 
+	outputParameters := []outputParameter{}
 	for _, param := range cfg.OutputParameters {
+		if wide || !param.Wide {
+			outputParameters = append(outputParameters, param)
+		}
+	}
+
+	return newTableInfo(Headers, fieldConverters, outputParameters, colored)
+}
+
+// newTableInfo builds table info with given columns followed by columns built from output parameters
+func newTableInfo(Headers []interface{}, fieldConverters []FieldConverter, outputParameters []outputParameter, colored bool) TableInfo {
+	for _, param := range outputParameters {
 		Headers = append(Headers, param.Name)
-		fieldConverters = append(fieldConverters, genericFieldConverter(param.ResourcePath))
+		fieldConverters = append(fieldConverters, typedFieldConverter(param, colored))
 	}
 
 	return TableInfo{
@@ -165,10 +217,6 @@ type TableInfo struct {
 }
 
 func convertResourcesToTable(resources []unstructured.Unstructured, rowConverter RowConverter) [][]interface{} {
-	slices.SortFunc(resources, func(a, b unstructured.Unstructured) int {
-		return cmp.Compare(a.GetNamespace(), b.GetNamespace())
-	})
-
 	var result [][]interface{}
 	for _, resource := range resources {
 		result = append(result, rowConverter(resource))
@@ -0,0 +1,352 @@
+package actions
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itchyny/gojq"
+	cmd_types "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	stringParameterType    outputParameterType = "string"
+	ageParameterType       outputParameterType = "age"
+	timestampParameterType outputParameterType = "timestamp"
+	quantityParameterType  outputParameterType = "quantity"
+	boolParameterType      outputParameterType = "bool"
+	conditionParameterType outputParameterType = "condition"
+
+	ascSortOrder  sortOrder = "asc"
+	descSortOrder sortOrder = "desc"
+)
+
+var outputParameterTypes = []outputParameterType{
+	stringParameterType,
+	ageParameterType,
+	timestampParameterType,
+	quantityParameterType,
+	boolParameterType,
+	conditionParameterType,
+}
+
+// outputParameterType defines how the output parameter value is displayed and sorted
+type outputParameterType string
+
+func (t *outputParameterType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	value := ""
+	err := unmarshal(&value)
+	if err != nil {
+		return err
+	}
+
+	if value != "" && !slices.Contains(outputParameterTypes, outputParameterType(value)) {
+		return fmt.Errorf("invalid output parameter type '%s'", value)
+	}
+
+	*t = outputParameterType(value)
+	return nil
+}
+
+type sortOrder string
+
+func (o *sortOrder) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	value := ""
+	err := unmarshal(&value)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains([]sortOrder{"", ascSortOrder, descSortOrder}, sortOrder(value)) {
+		return fmt.Errorf("invalid sort order '%s'", value)
+	}
+
+	*o = sortOrder(value)
+	return nil
+}
+
+// getOutput is the output format of the resource_get action
+// besides the json and yaml formats it supports kubectl-like wide, custom-columns=<spec> and jsonpath=<template> formats
+type getOutput struct {
+	format        cmd_types.Format
+	wide          bool
+	customColumns []outputParameter
+	jsonPath      string
+}
+
+func (o *getOutput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	value := ""
+	err := unmarshal(&value)
+	if err != nil {
+		return err
+	}
+
+	return o.Set(value)
+}
+
+func (o *getOutput) Set(value string) error {
+	*o = getOutput{}
+	switch {
+	case value == "wide":
+		o.wide = true
+	case strings.HasPrefix(value, "custom-columns="):
+		columns, err := parseCustomColumns(strings.TrimPrefix(value, "custom-columns="))
+		if err != nil {
+			return err
+		}
+		o.customColumns = columns
+	case strings.HasPrefix(value, "jsonpath="):
+		o.jsonPath = strings.TrimPrefix(value, "jsonpath=")
+		if o.jsonPath == "" {
+			return fmt.Errorf("empty jsonpath template")
+		}
+	default:
+		err := o.format.Set(value)
+		if err != nil {
+			return fmt.Errorf("invalid output format '%s', expected one of: json, yaml, wide, custom-columns=<spec>, jsonpath=<template>", value)
+		}
+	}
+
+	return nil
+}
+
+// parseCustomColumns parses columns in the <header>:<path> format separated by commas
+func parseCustomColumns(spec string) ([]outputParameter, error) {
+	columns := []outputParameter{}
+	for _, column := range strings.Split(spec, ",") {
+		name, path, ok := strings.Cut(column, ":")
+		if !ok || name == "" || path == "" {
+			return nil, fmt.Errorf("invalid custom-columns spec '%s', expected <header>:<path> columns separated by commas", spec)
+		}
+
+		columns = append(columns, outputParameter{
+			Name:         name,
+			ResourcePath: path,
+		})
+	}
+
+	return columns, nil
+}
+
+// typedFieldConverter returns converter formatting value from the resource path according to the parameter type
+func typedFieldConverter(param outputParameter, colored bool) FieldConverter {
+	if param.Type == "" || param.Type == stringParameterType {
+		return genericFieldConverter(param.ResourcePath)
+	}
+
+	return func(u unstructured.Unstructured) string {
+		value := queryValue(u, param.ResourcePath)
+		if value == nil {
+			return ""
+		}
+
+		return formatParameterValue(param.Type, value, colored)
+	}
+}
+
+func formatParameterValue(paramType outputParameterType, value interface{}, colored bool) string {
+	switch paramType {
+	case ageParameterType:
+		if t, ok := parseTime(value); ok {
+			return duration.HumanDuration(time.Since(t))
+		}
+	case timestampParameterType:
+		if t, ok := parseTime(value); ok {
+			return t.Local().Format(time.DateTime)
+		}
+	case quantityParameterType:
+		if q, ok := parseQuantity(value); ok {
+			return q.String()
+		}
+	case boolParameterType:
+		if b, ok := parseBool(value); ok {
+			return colorizeStatus(strconv.FormatBool(b), b, !b, colored)
+		}
+	case conditionParameterType:
+		status, reason := parseCondition(value)
+		text := status
+		if status != "True" && reason != "" {
+			text = fmt.Sprintf("%s (%s)", status, reason)
+		}
+		return colorizeStatus(text, status == "True", status == "False", colored)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// colorizeStatus colors positive values green, negative values red and others yellow
+func colorizeStatus(text string, positive, negative, colored bool) string {
+	if !colored {
+		return text
+	}
+
+	switch {
+	case positive:
+		return common.Colorize(common.ColorGreen, text)
+	case negative:
+		return common.Colorize(common.ColorRed, text)
+	default:
+		return common.Colorize(common.ColorYellow, text)
+	}
+}
+
+// sortResources sorts resources by the sortBy column, by the column with default sort or by namespace
+func sortResources(resources []unstructured.Unstructured, cfg *resourceGetActionConfig) error {
+	param, order, err := findSortParameter(cfg)
+	if err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(resources, func(a, b unstructured.Unstructured) int {
+		result := compareParameterValues(param.Type,
+			queryValue(a, param.ResourcePath), queryValue(b, param.ResourcePath))
+		if order == descSortOrder {
+			return -result
+		}
+
+		return result
+	})
+
+	return nil
+}
+
+func findSortParameter(cfg *resourceGetActionConfig) (outputParameter, sortOrder, error) {
+	namespaceParam := outputParameter{Name: "namespace", ResourcePath: ".metadata.namespace"}
+	params := slices.Concat([]outputParameter{
+		namespaceParam,
+		{Name: "name", ResourcePath: ".metadata.name"},
+	}, cfg.OutputParameters)
+
+	if cfg.SortBy != "" {
+		names := []string{}
+		for _, param := range params {
+			if param.Name == cfg.SortBy {
+				return param, cmp.Or(param.DefaultSort, ascSortOrder), nil
+			}
+			names = append(names, param.Name)
+		}
+
+		return outputParameter{}, "", fmt.Errorf("unknown sortBy column '%s', expected one of: %s", cfg.SortBy, strings.Join(names, ", "))
+	}
+
+	for _, param := range cfg.OutputParameters {
+		if param.DefaultSort != "" {
+			return param, param.DefaultSort, nil
+		}
+	}
+
+	return namespaceParam, ascSortOrder, nil
+}
+
+func compareParameterValues(paramType outputParameterType, a, b interface{}) int {
+	switch paramType {
+	case ageParameterType:
+		// lower age means later timestamp
+		aTime, _ := parseTime(a)
+		bTime, _ := parseTime(b)
+		return bTime.Compare(aTime)
+	case timestampParameterType:
+		aTime, _ := parseTime(a)
+		bTime, _ := parseTime(b)
+		return aTime.Compare(bTime)
+	case quantityParameterType:
+		aQuantity, _ := parseQuantity(a)
+		bQuantity, _ := parseQuantity(b)
+		return aQuantity.Cmp(bQuantity)
+	case boolParameterType:
+		aBool, _ := parseBool(a)
+		bBool, _ := parseBool(b)
+		return cmp.Compare(strconv.FormatBool(aBool), strconv.FormatBool(bBool))
+	case conditionParameterType:
+		aStatus, _ := parseCondition(a)
+		bStatus, _ := parseCondition(b)
+		return cmp.Compare(aStatus, bStatus)
+	}
+
+	aNumber, aIsNumber := a.(float64)
+	bNumber, bIsNumber := b.(float64)
+	if aIsNumber && bIsNumber {
+		return cmp.Compare(aNumber, bNumber)
+	}
+
+	return cmp.Compare(valueToString(a), valueToString(b))
+}
+
+// queryValue returns value from the jq path or nil if it does not exist
+func queryValue(u unstructured.Unstructured, path string) interface{} {
+	query, err := gojq.Parse(path)
+	if err != nil {
+		return nil
+	}
+
+	value, ok := query.Run(u.Object).Next()
+	if _, isError := value.(error); !ok || isError {
+		return nil
+	}
+
+	return value
+}
+
+func valueToString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func parseTime(value interface{}) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, valueToString(value))
+	return t, err == nil
+}
+
+func parseQuantity(value interface{}) (resource.Quantity, bool) {
+	q, err := resource.ParseQuantity(valueToString(value))
+	return q, err == nil
+}
+
+func parseBool(value interface{}) (bool, bool) {
+	b, err := strconv.ParseBool(valueToString(value))
+	return b, err == nil
+}
+
+// parseCondition returns status and reason of the condition object or the value itself as the status
+func parseCondition(value interface{}) (string, string) {
+	condition, ok := value.(map[string]interface{})
+	if !ok {
+		return valueToString(value), ""
+	}
+
+	return valueToString(condition["status"]), valueToString(condition["reason"])
+}
+
+// renderJSONPath executes the kubectl-like jsonpath template on the given data
+func renderJSONPath(template string, data interface{}) (string, error) {
+	if !strings.Contains(template, "{") {
+		// allow relaxed templates like kubectl does, for example ".items[*].metadata.name"
+		template = fmt.Sprintf("{%s}", template)
+	}
+
+	jp := jsonpath.New("output").AllowMissingKeys(true)
+	err := jp.Parse(template)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse jsonpath template: %w", err)
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	err = jp.Execute(buf, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute jsonpath template: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,267 @@
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_resourceGetAction_Run(t *testing.T) {
+	t.Run("get resources selected by labels and fields", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnListObjs: fixGetResources(),
+		}
+		action := fixResourcePatchAction(NewResourceGet, rootlessDynamic)
+
+		clierr := action.Configure(map[string]interface{}{
+			"labelSelector": "app=test",
+			"fieldSelector": "metadata.namespace!=kube-system",
+			"resource": map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+			},
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []rootlessdynamic.ListOptions{
+			{
+				LabelSelector: "app=test",
+				FieldSelector: "metadata.namespace!=kube-system",
+			},
+		}, rootlessDynamic.ListOpts)
+	})
+
+	t.Run("invalid label selector", func(t *testing.T) {
+		action := fixResourcePatchAction(NewResourceGet, &kubefake.RootlessDynamicClient{})
+
+		clierr := action.Configure(map[string]interface{}{
+			"labelSelector": "app in (test",
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid labelSelector")
+	})
+}
+
+func Test_resourceGetAction_formatOutput(t *testing.T) {
+	outputParameters := []interface{}{
+		map[string]interface{}{
+			"name":         "ready",
+			"resourcePath": `.status.conditions[] | select(.type=="Ready")`,
+			"type":         "condition",
+		},
+		map[string]interface{}{
+			"name":         "memory",
+			"resourcePath": ".spec.memory",
+			"type":         "quantity",
+			"wide":         true,
+		},
+		map[string]interface{}{
+			"name":         "age",
+			"resourcePath": ".metadata.creationTimestamp",
+			"type":         "age",
+			"defaultSort":  "desc",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		config    map[string]interface{}
+		resources *unstructured.UnstructuredList
+		want      string
+	}{
+		{
+			name: "table sorted by default sort",
+			config: map[string]interface{}{
+				"outputParameters": outputParameters,
+			},
+			want: "NAME    READY                 AGE   \n" +
+				"app-b   False (Unavailable)   30h   \n" +
+				"app-a   True                  2m    \n",
+		},
+		{
+			name: "wide table sorted by sortBy column",
+			config: map[string]interface{}{
+				"output":           "wide",
+				"sortBy":           "memory",
+				"outputParameters": outputParameters,
+			},
+			want: "NAME    READY                 MEMORY   AGE   \n" +
+				"app-a   True                  512Mi    2m    \n" +
+				"app-b   False (Unavailable)   1Gi      30h   \n",
+		},
+		{
+			name: "custom columns",
+			config: map[string]interface{}{
+				"output":           "custom-columns=APP:.metadata.name,MEM:.spec.memory",
+				"outputParameters": outputParameters,
+			},
+			want: "APP     MEM     \n" +
+				"app-b   1Gi     \n" +
+				"app-a   512Mi   \n",
+		},
+		{
+			name: "jsonpath",
+			config: map[string]interface{}{
+				"output":           "jsonpath=.items[*].metadata.name",
+				"outputParameters": outputParameters,
+			},
+			want: "app-b app-a",
+		},
+		{
+			name: "jsonpath for resource with name",
+			config: map[string]interface{}{
+				"output": "jsonpath={.spec.memory}",
+				"resource": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"name": "app-a",
+					},
+				},
+			},
+			resources: &unstructured.UnstructuredList{
+				Items: fixGetResources().Items[:1],
+			},
+			want: "512Mi",
+		},
+		{
+			name: "json with wide parameters",
+			config: map[string]interface{}{
+				"output":           "json",
+				"sortBy":           "name",
+				"outputParameters": outputParameters[:2],
+			},
+			want: `[
+  {
+    "memory": "512Mi",
+    "name": "app-a",
+    "ready": "True"
+  },
+  {
+    "memory": "1Gi",
+    "name": "app-b",
+    "ready": "False (Unavailable)"
+  }
+]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := &resourceGetAction{}
+			clierr := action.Configure(tt.config, nil)
+			require.Nil(t, clierr)
+
+			resources := tt.resources
+			if resources == nil {
+				resources = fixGetResources()
+			}
+
+			output, err := action.formatOutput(resources)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+
+	t.Run("unknown sortBy column", func(t *testing.T) {
+		action := &resourceGetAction{}
+		clierr := action.Configure(map[string]interface{}{
+			"sortBy":           "status",
+			"outputParameters": outputParameters,
+		}, nil)
+		require.Nil(t, clierr)
+
+		output, err := action.formatOutput(fixGetResources())
+		require.EqualError(t, err, "unknown sortBy column 'status', expected one of: namespace, name, ready, memory, age")
+		require.Empty(t, output)
+	})
+
+	t.Run("wrong configuration", func(t *testing.T) {
+		for _, config := range []map[string]interface{}{
+			{"output": "table"},
+			{"output": "custom-columns=NAME"},
+			{"output": "jsonpath="},
+			{"outputParameters": []interface{}{map[string]interface{}{"type": "duration"}}},
+			{"outputParameters": []interface{}{map[string]interface{}{"defaultSort": "up"}}},
+		} {
+			action := &resourceGetAction{}
+			clierr := action.Configure(config, nil)
+			require.NotNil(t, clierr)
+		}
+	})
+}
+
+func Test_getOutput_Set(t *testing.T) {
+	output := getOutput{}
+	require.NoError(t, output.Set("wide"))
+	require.Equal(t, getOutput{wide: true}, output)
+
+	require.NoError(t, output.Set("custom-columns=NAME:.metadata.name,AGE:.metadata.creationTimestamp"))
+	require.Equal(t, getOutput{customColumns: []outputParameter{
+		{Name: "NAME", ResourcePath: ".metadata.name"},
+		{Name: "AGE", ResourcePath: ".metadata.creationTimestamp"},
+	}}, output)
+
+	require.NoError(t, output.Set("yaml"))
+	require.Equal(t, getOutput{format: "yaml"}, output)
+
+	require.EqualError(t, output.Set("custom-columns=NAME:"),
+		"invalid custom-columns spec 'NAME:', expected <header>:<path> columns separated by commas")
+	require.EqualError(t, output.Set("table"),
+		"invalid output format 'table', expected one of: json, yaml, wide, custom-columns=<spec>, jsonpath=<template>")
+}
+
+func Test_formatParameterValue(t *testing.T) {
+	timestamp := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	require.Equal(t, "3h", formatParameterValue(ageParameterType, time.Now().Add(-3*time.Hour).Format(time.RFC3339), false))
+	require.Equal(t, timestamp.Local().Format(time.DateTime), formatParameterValue(timestampParameterType, "2025-01-02T03:04:05Z", false))
+	require.Equal(t, "1536Mi", formatParameterValue(quantityParameterType, "1.5Gi", false))
+	require.Equal(t, "not a time", formatParameterValue(ageParameterType, "not a time", false))
+	require.Equal(t, "true", formatParameterValue(boolParameterType, true, false))
+	require.Equal(t, common.Colorize(common.ColorRed, "false"), formatParameterValue(boolParameterType, "false", true))
+	require.Equal(t, common.Colorize(common.ColorYellow, "Unknown"), formatParameterValue(conditionParameterType, "Unknown", true))
+	require.Equal(t, common.Colorize(common.ColorGreen, "True"), formatParameterValue(conditionParameterType,
+		map[string]interface{}{"status": "True", "reason": "Ready"}, true))
+}
+
+func fixGetResources() *unstructured.UnstructuredList {
+	return &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			fixGetResource("app-a", "512Mi", "True", "", time.Now().Add(-2*time.Minute)),
+			fixGetResource("app-b", "1Gi", "False", "Unavailable", time.Now().Add(-30*time.Hour)),
+		},
+	}
+}
+
+func fixGetResource(name, memory, ready, reason string, created time.Time) unstructured.Unstructured {
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":              name,
+				"creationTimestamp": created.Format(time.RFC3339),
+			},
+			"spec": map[string]interface{}{
+				"memory": memory,
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":   "Ready",
+						"status": ready,
+						"reason": reason,
+					},
+				},
+			},
+		},
+	}
+}
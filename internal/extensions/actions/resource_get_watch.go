@@ -0,0 +1,262 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	cmd_types "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// escape codes moving the cursor to the top left corner and clearing the screen
+const clearScreen = "\033[H\033[2J"
+
+// resourcesWatcher keeps the current state of watched resources and prints them on every change
+type resourcesWatcher struct {
+	action    *resourceGetAction
+	client    rootlessdynamic.Interface
+	printer   *out.Printer
+	resources map[string]unstructured.Unstructured
+	// clear the screen before rendering the table to display it in place
+	clear bool
+}
+
+func newResourcesWatcher(action *resourceGetAction, client rootlessdynamic.Interface, printer *out.Printer) *resourcesWatcher {
+	return &resourcesWatcher{
+		action:    action,
+		client:    client,
+		printer:   printer,
+		resources: map[string]unstructured.Unstructured{},
+		clear:     term.IsTerminal(int(os.Stdout.Fd())),
+	}
+}
+
+// watch prints listed resources and then re-renders the table or streams events until the context is done
+func (w *resourcesWatcher) watch(ctx context.Context, u *unstructured.Unstructured, opts rootlessdynamic.ListOptions, list *unstructured.UnstructuredList) clierror.Error {
+	clierr := w.printListed(list)
+	if clierr != nil {
+		return clierr
+	}
+
+	opts.ResourceVersion = list.GetResourceVersion()
+	for {
+		watcher, err := w.client.Watch(ctx, u, &opts)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to watch resources"))
+		}
+
+		resourceVersion, expired, clierr := w.handleEvents(ctx, watcher)
+		watcher.Stop()
+		if clierr != nil || ctx.Err() != nil {
+			return clierr
+		}
+
+		if expired {
+			// the resource version is too old to continue, list resources again and start the next watch from the new version
+			list, err := w.client.List(ctx, u, &opts)
+			if err != nil {
+				return clierror.Wrap(err, clierror.New("failed to list resources"))
+			}
+
+			clierr = w.syncListed(list)
+			if clierr != nil {
+				return clierr
+			}
+
+			opts.ResourceVersion = list.GetResourceVersion()
+		} else if resourceVersion != "" {
+			// the server closed the watch, start the next one from the last seen resource version
+			opts.ResourceVersion = resourceVersion
+		}
+	}
+}
+
+// handleEvents handles all events until the watch is closed or the context is done and returns the last seen resource version
+// it returns true if the watch ended because the resource version expired and resources must be listed again
+func (w *resourcesWatcher) handleEvents(ctx context.Context, watcher watch.Interface) (string, bool, clierror.Error) {
+	resourceVersion := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, false, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, false, nil
+			}
+
+			if event.Type == watch.Error {
+				err := apierrors.FromObject(event.Object)
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					return resourceVersion, true, nil
+				}
+
+				return resourceVersion, false, clierror.Wrap(err, clierror.New("failed to watch resources"))
+			}
+
+			resource, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				// skip unexpected objects
+				continue
+			}
+
+			resourceVersion = resource.GetResourceVersion()
+			if event.Type == watch.Bookmark {
+				continue
+			}
+
+			clierr := w.handleEvent(event.Type, *resource)
+			if clierr != nil {
+				return resourceVersion, false, clierr
+			}
+		}
+	}
+}
+
+// printListed stores listed resources and prints them as the table or as the ADDED events
+func (w *resourcesWatcher) printListed(list *unstructured.UnstructuredList) clierror.Error {
+	for _, resource := range list.Items {
+		w.resources[resourceKey(resource)] = resource
+		if w.structuredOutput() {
+			clierr := w.printEvent(watch.Added, resource)
+			if clierr != nil {
+				return clierr
+			}
+		}
+	}
+
+	if w.structuredOutput() {
+		return nil
+	}
+
+	return w.printTable()
+}
+
+// syncListed replaces stored resources with the listed ones and prints changes missed since the last watch
+func (w *resourcesWatcher) syncListed(list *unstructured.UnstructuredList) clierror.Error {
+	listed := map[string]unstructured.Unstructured{}
+	for _, resource := range list.Items {
+		listed[resourceKey(resource)] = resource
+	}
+
+	if !w.structuredOutput() {
+		w.resources = listed
+		return w.printTable()
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(w.resources)) {
+		if _, ok := listed[key]; !ok {
+			clierr := w.handleEvent(watch.Deleted, w.resources[key])
+			if clierr != nil {
+				return clierr
+			}
+		}
+	}
+
+	for _, resource := range list.Items {
+		stored, ok := w.resources[resourceKey(resource)]
+		eventType := watch.Added
+		if ok {
+			if stored.GetResourceVersion() == resource.GetResourceVersion() {
+				continue
+			}
+			eventType = watch.Modified
+		}
+
+		clierr := w.handleEvent(eventType, resource)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	return nil
+}
+
+func (w *resourcesWatcher) handleEvent(eventType watch.EventType, resource unstructured.Unstructured) clierror.Error {
+	if eventType == watch.Deleted {
+		delete(w.resources, resourceKey(resource))
+	} else {
+		w.resources[resourceKey(resource)] = resource
+	}
+
+	if w.structuredOutput() {
+		return w.printEvent(eventType, resource)
+	}
+
+	return w.printTable()
+}
+
+func (w *resourcesWatcher) structuredOutput() bool {
+	output := w.action.Cfg.OutputFormat
+	return output.format != cmd_types.DefaultFormat || output.jsonPath != ""
+}
+
+// printTable renders the table with the current state of all watched resources
+func (w *resourcesWatcher) printTable() clierror.Error {
+	// sort by key first to keep the same order of resources not sorted by the configured column
+	list := &unstructured.UnstructuredList{
+		Items: slices.SortedFunc(maps.Values(w.resources), func(a, b unstructured.Unstructured) int {
+			return strings.Compare(resourceKey(a), resourceKey(b))
+		}),
+	}
+
+	output, err := w.action.formatOutput(list)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to format output"))
+	}
+
+	if w.clear {
+		w.printer.Msg(clearScreen)
+	}
+
+	w.printer.Msgln(output)
+	return nil
+}
+
+// printEvent prints the event with the changed resource in the json, yaml or jsonpath format
+func (w *resourcesWatcher) printEvent(eventType watch.EventType, resource unstructured.Unstructured) clierror.Error {
+	output, err := w.formatEvent(eventType, resource)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to format output"))
+	}
+
+	w.printer.Msgln(output)
+	return nil
+}
+
+func (w *resourcesWatcher) formatEvent(eventType watch.EventType, resource unstructured.Unstructured) (string, error) {
+	output := w.action.Cfg.OutputFormat
+	if output.jsonPath != "" {
+		return renderJSONPath(output.jsonPath, resource.Object)
+	}
+
+	tableInfo := buildTableInfo(&w.action.Cfg, true, false)
+	event := map[string]interface{}{
+		"type":   eventType,
+		"object": convertResourcesToParameters([]unstructured.Unstructured{resource}, tableInfo)[0],
+	}
+
+	if output.format == cmd_types.YAMLFormat {
+		obj, err := yaml.Marshal(event)
+		return "---\n" + strings.TrimSuffix(string(obj), "\n"), err
+	}
+
+	// every event is printed in one line to allow processing the stream line by line
+	obj, err := json.Marshal(event)
+	return string(obj), err
+}
+
+func resourceKey(resource unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s", resource.GetNamespace(), resource.GetName())
+}
@@ -0,0 +1,173 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func Test_resourcesWatcher_watch(t *testing.T) {
+	t.Run("re-render table on every change", func(t *testing.T) {
+		output, clierr, client := runFixWatcher(t, map[string]interface{}{}, func(fakeWatcher *watch.FakeWatcher) {
+			fakeWatcher.Add(fixWatchResource("app-b", "2"))
+			fakeWatcher.Delete(fixWatchResource("app-a", "3"))
+		})
+		require.Nil(t, clierr)
+		require.Equal(t, "NAME    \napp-a   \n\n"+
+			"NAME    \napp-a   \napp-b   \n\n"+
+			"NAME    \napp-b   \n\n", output)
+		require.Equal(t, []rootlessdynamic.ListOptions{
+			{FieldSelector: "metadata.name==app", ResourceVersion: "1"},
+		}, client.WatchOpts)
+	})
+
+	t.Run("stream json events", func(t *testing.T) {
+		output, clierr, _ := runFixWatcher(t, map[string]interface{}{
+			"output": "json",
+		}, func(fakeWatcher *watch.FakeWatcher) {
+			fakeWatcher.Modify(fixWatchResource("app-a", "2"))
+		})
+		require.Nil(t, clierr)
+		require.Equal(t, `{"object":{"name":"app-a"},"type":"ADDED"}`+"\n"+
+			`{"object":{"name":"app-a"},"type":"MODIFIED"}`+"\n", output)
+	})
+
+	t.Run("stream jsonpath results", func(t *testing.T) {
+		output, clierr, _ := runFixWatcher(t, map[string]interface{}{
+			"output": "jsonpath={.metadata.resourceVersion}",
+		}, func(fakeWatcher *watch.FakeWatcher) {
+			fakeWatcher.Modify(fixWatchResource("app-a", "2"))
+		})
+		require.Nil(t, clierr)
+		require.Equal(t, "1\n2\n", output)
+	})
+
+	t.Run("relist and watch again when resource version expired", func(t *testing.T) {
+		action := &resourceGetAction{}
+		require.Nil(t, action.Configure(map[string]interface{}{"output": "json"}, nil))
+
+		expiredWatcher := watch.NewFake()
+		nextWatcher := watch.NewFake()
+		client := &kubefake.RootlessDynamicClient{
+			ReturnWatchers: []watch.Interface{expiredWatcher, nextWatcher},
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Object: map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"resourceVersion": "5",
+					},
+				},
+				Items: []unstructured.Unstructured{*fixWatchResource("app-a", "1"), *fixWatchResource("app-c", "4")},
+			},
+		}
+		buf := bytes.NewBuffer([]byte{})
+		watcher := newResourcesWatcher(action, client, out.NewToWriter(buf))
+
+		list := &unstructured.UnstructuredList{
+			Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"resourceVersion": "1",
+				},
+			},
+			Items: []unstructured.Unstructured{*fixWatchResource("app-a", "1"), *fixWatchResource("app-b", "1")},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			statusErr := apierrors.NewResourceExpired("too old resource version")
+			statusErr.ErrStatus.Code = http.StatusGone
+			expiredWatcher.Error(&statusErr.ErrStatus)
+			nextWatcher.Add(fixWatchResource("app-d", "6"))
+			cancel()
+		}()
+
+		clierr := watcher.watch(ctx, &unstructured.Unstructured{}, rootlessdynamic.ListOptions{}, list)
+		require.Nil(t, clierr)
+		require.Equal(t, `{"object":{"name":"app-a"},"type":"ADDED"}`+"\n"+
+			`{"object":{"name":"app-b"},"type":"ADDED"}`+"\n"+
+			`{"object":{"name":"app-b"},"type":"DELETED"}`+"\n"+
+			`{"object":{"name":"app-c"},"type":"ADDED"}`+"\n"+
+			`{"object":{"name":"app-d"},"type":"ADDED"}`+"\n", buf.String())
+		require.Len(t, client.ListOpts, 1)
+		require.Equal(t, []rootlessdynamic.ListOptions{
+			{ResourceVersion: "1"},
+			{ResourceVersion: "5"},
+		}, client.WatchOpts)
+	})
+
+	t.Run("watch error event", func(t *testing.T) {
+		statusErr := apierrors.NewInternalError(errors.New("etcd is not available"))
+		_, clierr, _ := runFixWatcher(t, map[string]interface{}{}, func(fakeWatcher *watch.FakeWatcher) {
+			fakeWatcher.Error(&statusErr.ErrStatus)
+		})
+		require.Equal(t, clierror.Wrap(apierrors.FromObject(&statusErr.ErrStatus), clierror.New("failed to watch resources")), clierr)
+	})
+
+	t.Run("watch error", func(t *testing.T) {
+		action := &resourceGetAction{}
+		client := &kubefake.RootlessDynamicClient{
+			ReturnWatchErr: apierrors.NewNotFound(schema.GroupResource{}, "app"),
+		}
+
+		clierr := newResourcesWatcher(action, client, out.NewToWriter(bytes.NewBuffer([]byte{}))).
+			watch(context.Background(), &unstructured.Unstructured{}, rootlessdynamic.ListOptions{}, &unstructured.UnstructuredList{})
+		require.Equal(t, clierror.Wrap(apierrors.NewNotFound(schema.GroupResource{}, "app"), clierror.New("failed to watch resources")), clierr)
+	})
+}
+
+// runFixWatcher watches the app-a resource, runs sendEvents and stops watching after all events are handled
+func runFixWatcher(t *testing.T, config map[string]interface{}, sendEvents func(*watch.FakeWatcher)) (string, clierror.Error, *kubefake.RootlessDynamicClient) {
+	action := &resourceGetAction{}
+	require.Nil(t, action.Configure(config, nil))
+
+	fakeWatcher := watch.NewFake()
+	client := &kubefake.RootlessDynamicClient{
+		ReturnWatcher: fakeWatcher,
+	}
+	buf := bytes.NewBuffer([]byte{})
+	watcher := newResourcesWatcher(action, client, out.NewToWriter(buf))
+	watcher.clear = false
+
+	list := &unstructured.UnstructuredList{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"resourceVersion": "1",
+			},
+		},
+		Items: []unstructured.Unstructured{*fixWatchResource("app-a", "1")},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		// fake watcher channel is unbuffered so all events are received when sendEvents returns
+		sendEvents(fakeWatcher)
+		cancel()
+	}()
+
+	clierr := watcher.watch(ctx, &unstructured.Unstructured{}, rootlessdynamic.ListOptions{
+		FieldSelector: "metadata.name==app",
+	}, list)
+	return buf.String(), clierr, client
+}
+
+func fixWatchResource(name, resourceVersion string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":            name,
+				"resourceVersion": resourceVersion,
+			},
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package actions
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type resourceLogsActionConfig struct {
+	Target     podTargetConfig `yaml:"target"`
+	Follow     bool            `yaml:"follow"`
+	Since      string          `yaml:"since"`
+	Tail       int64           `yaml:"tail"`
+	Previous   bool            `yaml:"previous"`
+	Timestamps bool            `yaml:"timestamps"`
+}
+
+func (c *resourceLogsActionConfig) validate() clierror.Error {
+	clierr := c.Target.validate()
+	if clierr != nil {
+		return clierr
+	}
+
+	if c.Since != "" {
+		_, err := time.ParseDuration(c.Since)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to parse since duration"))
+		}
+	}
+
+	return nil
+}
+
+func (c *resourceLogsActionConfig) podLogOptions(container string) *corev1.PodLogOptions {
+	opts := &corev1.PodLogOptions{
+		Container:  container,
+		Follow:     c.Follow,
+		Previous:   c.Previous,
+		Timestamps: c.Timestamps,
+	}
+
+	if c.Tail > 0 {
+		tail := c.Tail
+		opts.TailLines = &tail
+	}
+
+	if c.Since != "" {
+		// duration is already validated
+		since, _ := time.ParseDuration(c.Since)
+		sinceSeconds := int64(since.Seconds())
+		opts.SinceSeconds = &sinceSeconds
+	}
+
+	return opts
+}
+
+type resourceLogsAction struct {
+	common.TemplateConfigurator[resourceLogsActionConfig]
+
+	kymaConfig *cmdcommon.KymaConfig
+}
+
+func NewResourceLogs(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &resourceLogsAction{
+		kymaConfig: kymaConfig,
+	}
+}
+
+func (a *resourceLogsAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	clierr := a.Cfg.validate()
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("invalid action configuration"))
+	}
+
+	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	pod, container, clierr := getTargetPod(a.kymaConfig.Ctx, client.Static(), a.Cfg.Target)
+	if clierr != nil {
+		return clierr
+	}
+
+	out.Debugfln("Streaming logs of the %s container from the %s/%s Pod", container, pod.Namespace, pod.Name)
+
+	logStream, err := client.Static().CoreV1().Pods(pod.Namespace).
+		GetLogs(pod.Name, a.Cfg.podLogOptions(container)).
+		Stream(a.kymaConfig.Ctx)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get logs stream"))
+	}
+	defer logStream.Close()
+
+	err = printLogs(out.Default, logStream)
+	if err != nil && a.kymaConfig.Ctx.Err() == nil {
+		return clierror.Wrap(err, clierror.New("failed to read logs"))
+	}
+
+	return nil
+}
+
+// printLogs prints logs line by line without limiting the line length
+func printLogs(printer *out.Printer, logStream io.Reader) error {
+	reader := bufio.NewReader(logStream)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			printer.Msgln(strings.TrimSuffix(line, "\n"))
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
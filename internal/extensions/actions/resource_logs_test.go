@@ -0,0 +1,155 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_resourceLogsAction_Run(t *testing.T) {
+	t.Run("stream logs", func(t *testing.T) {
+		action := NewResourceLogs(&cmdcommon.KymaConfig{
+			Ctx: context.Background(),
+			KubeClientConfig: &fakeKubeClientConfig{
+				kubeClient: &kubefake.KubeClient{
+					TestKubernetesInterface: k8sfake.NewClientset(fixTargetPod()),
+				},
+			},
+		})
+
+		clierr := action.Configure(map[string]interface{}{
+			"target": map[string]interface{}{
+				"namespace": "default",
+				"selector": map[string]interface{}{
+					"app": "test",
+				},
+			},
+			"since": "5m",
+			"tail":  10,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+	})
+
+	t.Run("no ready Pod", func(t *testing.T) {
+		action := NewResourceLogs(&cmdcommon.KymaConfig{
+			Ctx: context.Background(),
+			KubeClientConfig: &fakeKubeClientConfig{
+				kubeClient: &kubefake.KubeClient{
+					TestKubernetesInterface: k8sfake.NewClientset(),
+				},
+			},
+		})
+
+		clierr := action.Configure(map[string]interface{}{
+			"target": map[string]interface{}{
+				"namespace": "default",
+				"selector": map[string]interface{}{
+					"app": "test",
+				},
+			},
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to find target Pod")
+	})
+
+	t.Run("wrong since duration", func(t *testing.T) {
+		action := NewResourceLogs(&cmdcommon.KymaConfig{})
+
+		clierr := action.Configure(map[string]interface{}{
+			"target": map[string]interface{}{
+				"namespace": "default",
+				"selector": map[string]interface{}{
+					"app": "test",
+				},
+			},
+			"since": "yesterday",
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to parse since duration")
+	})
+}
+
+func Test_printLogs(t *testing.T) {
+	longLine := strings.Repeat("a", 100*1024)
+	buf := bytes.NewBuffer([]byte{})
+
+	err := printLogs(out.NewToWriter(buf), strings.NewReader("first\n"+longLine+"\nlast"))
+	require.NoError(t, err)
+	require.Equal(t, "first\n"+longLine+"\nlast\n", buf.String())
+}
+
+func Test_resourceLogsActionConfig_podLogOptions(t *testing.T) {
+	cfg := resourceLogsActionConfig{
+		Follow: true,
+		Since:  "1h",
+		Tail:   20,
+	}
+
+	tail := int64(20)
+	since := int64(3600)
+	require.Equal(t, &corev1.PodLogOptions{
+		Container:    "app",
+		Follow:       true,
+		TailLines:    &tail,
+		SinceSeconds: &since,
+	}, cfg.podLogOptions("app"))
+}
+
+func Test_defaultContainerName(t *testing.T) {
+	t.Run("first container", func(t *testing.T) {
+		require.Equal(t, "app", defaultContainerName(fixTargetPod()))
+	})
+
+	t.Run("container from annotation", func(t *testing.T) {
+		pod := fixTargetPod()
+		pod.Annotations = map[string]string{
+			defaultContainerAnnotation: "sidecar",
+		}
+		require.Equal(t, "sidecar", defaultContainerName(pod))
+	})
+}
+
+func fixTargetPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-pod",
+			Namespace: "default",
+			Labels: map[string]string{
+				"app": "test",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "app"},
+				{Name: "sidecar"},
+			},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:   corev1.ContainersReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	cmd_types "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8s_types "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	mergePatchType     = "merge"
+	strategicPatchType = "strategic"
+	applyPatchType     = "apply"
+)
+
+var patchTypes = map[string]k8s_types.PatchType{
+	mergePatchType:     k8s_types.MergePatchType,
+	strategicPatchType: k8s_types.StrategicMergePatchType,
+	applyPatchType:     k8s_types.ApplyPatchType,
+}
+
+type resourcePatchActionConfig struct {
+	PatchType     string                 `yaml:"patchType"`
+	DryRun        bool                   `yaml:"dryRun"`
+	Diff          bool                   `yaml:"diff"`
+	Output        cmd_types.Format       `yaml:"output"`
+	OutputMessage string                 `yaml:"outputMessage"`
+	Resource      map[string]interface{} `yaml:"resource"`
+}
+
+func (c *resourcePatchActionConfig) validate() clierror.Error {
+	if _, ok := patchTypes[c.PatchType]; !ok {
+		return clierror.New(
+			fmt.Sprintf("unsupported patch type '%s'", c.PatchType),
+			fmt.Sprintf("use one of: %s, %s, %s", mergePatchType, strategicPatchType, applyPatchType),
+		)
+	}
+
+	u := unstructured.Unstructured{Object: c.Resource}
+	if u.GetAPIVersion() == "" || u.GetKind() == "" || u.GetName() == "" {
+		return clierror.New("resource must contain apiVersion, kind, and metadata.name fields")
+	}
+
+	return nil
+}
+
+type resourcePatchAction struct {
+	common.TemplateConfigurator[resourcePatchActionConfig]
+
+	kymaConfig       *cmdcommon.KymaConfig
+	defaultPatchType string
+	outputs          map[string]interface{}
+}
+
+func NewResourcePatch(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &resourcePatchAction{
+		kymaConfig:       kymaConfig,
+		defaultPatchType: mergePatchType,
+	}
+}
+
+// NewResourceApply returns the resource_patch action that uses server-side apply by default
+func NewResourceApply(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &resourcePatchAction{
+		kymaConfig:       kymaConfig,
+		defaultPatchType: applyPatchType,
+	}
+}
+
+// Outputs returns the patched resource
+func (a *resourcePatchAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
+
+func (a *resourcePatchAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	a.outputs = nil
+	if a.Cfg.PatchType == "" {
+		a.Cfg.PatchType = a.defaultPatchType
+	}
+
+	clierr := a.Cfg.validate()
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("invalid action configuration"))
+	}
+
+	u := &unstructured.Unstructured{
+		Object: a.Cfg.Resource,
+	}
+
+	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	var before map[string]interface{}
+	if a.Cfg.Diff {
+		current, err := client.RootlessDynamic().Get(a.kymaConfig.Ctx, u)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return clierror.Wrap(err, clierror.New("failed to get resource"))
+		}
+		if err == nil {
+			before = current.Object
+		}
+	}
+
+	data, err := json.Marshal(u.Object)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to marshal patch"))
+	}
+
+	patched, err := client.RootlessDynamic().Patch(a.kymaConfig.Ctx, u, patchTypes[a.Cfg.PatchType], data, a.Cfg.DryRun)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to patch resource"))
+	}
+	a.outputs = map[string]interface{}{
+		"resource": patched.Object,
+	}
+
+	if a.Cfg.Diff {
+		clierr = printDiff(before, patched.Object)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	output, err := a.formatOutput(patched)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to format output"))
+	}
+
+	out.Msgln(output)
+
+	if a.Cfg.OutputMessage != "" && a.Cfg.Output == cmd_types.DefaultFormat {
+		out.Msgln(a.Cfg.OutputMessage)
+	}
+
+	return nil
+}
+
+func printDiff(before, after map[string]interface{}) clierror.Error {
+	diff, err := common.DiffObjects(before, after, common.IsColorTerminal())
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to generate diff"))
+	}
+
+	if diff == "" {
+		out.Msgln("no changes")
+		return nil
+	}
+
+	out.Msg(diff)
+	return nil
+}
+
+func (a *resourcePatchAction) formatOutput(u *unstructured.Unstructured) (string, error) {
+	if a.Cfg.Output == cmd_types.JSONFormat {
+		obj, err := json.MarshalIndent(u.Object, "", "  ")
+		return string(obj), err
+	}
+
+	if a.Cfg.Output == cmd_types.YAMLFormat {
+		obj, err := yaml.Marshal(u.Object)
+		return string(obj), err
+	}
+
+	messageSuffix := ""
+	if a.Cfg.DryRun {
+		messageSuffix = " (dry run)"
+	}
+
+	verb := "patched"
+	if a.Cfg.PatchType == applyPatchType {
+		verb = "applied"
+	}
+
+	return fmt.Sprintf("resource %s %s%s", u.GetName(), verb, messageSuffix), nil
+}
@@ -0,0 +1,133 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8s_types "k8s.io/apimachinery/pkg/types"
+)
+
+func Test_resourcePatchAction_Run(t *testing.T) {
+	t.Run("merge patch resource", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnGetObj:   fixPatchResource(1),
+			ReturnPatchObj: fixPatchResource(2),
+		}
+		action := fixResourcePatchAction(NewResourcePatch, rootlessDynamic)
+
+		clierr := action.Configure(map[string]interface{}{
+			"diff":     true,
+			"resource": fixPatchResource(2).Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []k8s_types.PatchType{k8s_types.MergePatchType}, rootlessDynamic.PatchTypes)
+		require.JSONEq(t, `{"apiVersion":"operator.kyma-project.io/v1alpha1","kind":"Serverless","metadata":{"name":"default","namespace":"kyma-system"},"spec":{"replicas":2}}`,
+			string(rootlessDynamic.PatchDatas[0]))
+		require.Len(t, rootlessDynamic.GetObjs, 1)
+		require.Equal(t, map[string]interface{}{
+			"resource": fixPatchResource(2).Object,
+		}, action.(*resourcePatchAction).Outputs())
+	})
+
+	t.Run("apply not existing resource", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnGetErr:   apierrors.NewNotFound(schema.GroupResource{}, "default"),
+			ReturnPatchObj: fixPatchResource(1),
+		}
+		action := fixResourcePatchAction(NewResourceApply, rootlessDynamic)
+
+		clierr := action.Configure(map[string]interface{}{
+			"diff":     true,
+			"resource": fixPatchResource(1).Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Nil(t, clierr)
+		require.Equal(t, []k8s_types.PatchType{k8s_types.ApplyPatchType}, rootlessDynamic.PatchTypes)
+	})
+
+	t.Run("unsupported patch type", func(t *testing.T) {
+		action := fixResourcePatchAction(NewResourcePatch, &kubefake.RootlessDynamicClient{})
+
+		clierr := action.Configure(map[string]interface{}{
+			"patchType": "json",
+			"resource":  fixPatchResource(1).Object,
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.Equal(t, clierror.WrapE(
+			clierror.New("unsupported patch type 'json'", "use one of: merge, strategic, apply"),
+			clierror.New("invalid action configuration"),
+		), clierr)
+	})
+
+	t.Run("missing resource name", func(t *testing.T) {
+		action := fixResourcePatchAction(NewResourcePatch, &kubefake.RootlessDynamicClient{})
+
+		clierr := action.Configure(map[string]interface{}{
+			"resource": map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+			},
+		}, nil)
+		require.Nil(t, clierr)
+
+		clierr = action.Run(&cobra.Command{}, []string{})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "resource must contain apiVersion, kind, and metadata.name fields")
+	})
+}
+
+func fixResourcePatchAction(newAction func(*cmdcommon.KymaConfig) types.Action, rootlessDynamic *kubefake.RootlessDynamicClient) types.Action {
+	return newAction(&cmdcommon.KymaConfig{
+		Ctx: context.Background(),
+		KubeClientConfig: &fakeKubeClientConfig{
+			kubeClient: &kubefake.KubeClient{
+				TestRootlessDynamicInterface: rootlessDynamic,
+			},
+		},
+	})
+}
+
+func fixPatchResource(replicas int64) unstructured.Unstructured {
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "operator.kyma-project.io/v1alpha1",
+			"kind":       "Serverless",
+			"metadata": map[string]interface{}{
+				"name":      "default",
+				"namespace": "kyma-system",
+			},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+			},
+		},
+	}
+}
+
+type fakeKubeClientConfig struct {
+	kubeClient kube.Client
+}
+
+func (f *fakeKubeClientConfig) GetKubeClient() (kube.Client, error) {
+	return f.kubeClient, nil
+}
+
+func (f *fakeKubeClientConfig) GetKubeClientWithClierr() (kube.Client, clierror.Error) {
+	return f.kubeClient, nil
+}
@@ -0,0 +1,54 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// resourceSelector selects resources by labels and fields in the same format as kubectl
+type resourceSelector struct {
+	LabelSelector string `yaml:"labelSelector"`
+	FieldSelector string `yaml:"fieldSelector"`
+}
+
+func (s *resourceSelector) empty() bool {
+	return s.LabelSelector == "" && s.FieldSelector == ""
+}
+
+func (s *resourceSelector) validate() clierror.Error {
+	_, err := labels.Parse(s.LabelSelector)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("invalid labelSelector"))
+	}
+
+	_, err = fields.ParseSelector(s.FieldSelector)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("invalid fieldSelector"))
+	}
+
+	return nil
+}
+
+// listOptions returns list options with selectors and the name field selector if the name is not empty
+func (s *resourceSelector) listOptions(name string, allNamespaces bool) rootlessdynamic.ListOptions {
+	fieldSelectors := []string{}
+	if name != "" {
+		// set name field selector to get only one resource
+		fieldSelectors = append(fieldSelectors, fmt.Sprintf("metadata.name==%s", name))
+	}
+
+	if s.FieldSelector != "" {
+		fieldSelectors = append(fieldSelectors, s.FieldSelector)
+	}
+
+	return rootlessdynamic.ListOptions{
+		AllNamespaces: allNamespaces,
+		FieldSelector: strings.Join(fieldSelectors, ","),
+		LabelSelector: s.LabelSelector,
+	}
+}
@@ -0,0 +1,247 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	readyState         = "Ready"
+	errorState         = "Error"
+	defaultWaitTimeout = 5 * time.Minute
+)
+
+type resourceWaitActionConfig struct {
+	Resource map[string]interface{} `yaml:"resource"`
+	// type of the condition that must have the True status
+	Condition string `yaml:"condition"`
+	// path and value that must be in the resource, shorthand for one state check mapped to the Ready state
+	JSONPath string `yaml:"jsonPath"`
+	Value    string `yaml:"value"`
+	// checks mapping resource fields to the Ready or Error state
+	StateChecks   []kyma.CustomStateCheck `yaml:"stateChecks"`
+	Timeout       string                  `yaml:"timeout"`
+	ShowProgress  bool                    `yaml:"showProgress"`
+	OutputMessage string                  `yaml:"outputMessage"`
+}
+
+// buildStateChecks returns all checks from the config in the CustomStateCheck format
+func (c *resourceWaitActionConfig) buildStateChecks() []kyma.CustomStateCheck {
+	checks := []kyma.CustomStateCheck{}
+	if c.Condition != "" {
+		checks = append(checks, kyma.CustomStateCheck{
+			JSONPath:    fmt.Sprintf(`.status.conditions[] | select(.type == "%s") | .status`, c.Condition),
+			Value:       "True",
+			MappedState: readyState,
+		})
+	}
+
+	if c.JSONPath != "" {
+		checks = append(checks, kyma.CustomStateCheck{
+			JSONPath:    c.JSONPath,
+			Value:       c.Value,
+			MappedState: readyState,
+		})
+	}
+
+	return append(checks, c.StateChecks...)
+}
+
+func (c *resourceWaitActionConfig) validate() clierror.Error {
+	u := unstructured.Unstructured{Object: c.Resource}
+	if u.GetName() == "" {
+		return clierror.New("empty resource name")
+	}
+
+	checks := c.buildStateChecks()
+	if len(checks) == 0 {
+		return clierror.New("at least one of condition, jsonPath or stateChecks must be set")
+	}
+
+	hasReadyCheck := false
+	for _, check := range checks {
+		if check.MappedState != readyState && check.MappedState != errorState {
+			return clierror.New(fmt.Sprintf("unsupported mappedState '%s' for path '%s'", check.MappedState, check.JSONPath),
+				"use Ready or Error")
+		}
+		hasReadyCheck = hasReadyCheck || check.MappedState == readyState
+	}
+
+	if !hasReadyCheck {
+		return clierror.New("at least one check must be mapped to the Ready state",
+			"set the condition or the jsonPath field, or add the stateChecks item with the Ready mappedState")
+	}
+
+	if c.Timeout != "" {
+		if _, err := time.ParseDuration(c.Timeout); err != nil {
+			return clierror.Wrap(err, clierror.New("invalid timeout"))
+		}
+	}
+
+	return nil
+}
+
+type resourceWaitAction struct {
+	common.TemplateConfigurator[resourceWaitActionConfig]
+
+	kymaConfig *cmdcommon.KymaConfig
+}
+
+func NewResourceWait(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &resourceWaitAction{
+		kymaConfig: kymaConfig,
+	}
+}
+
+func (a *resourceWaitAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	clierr := a.Cfg.validate()
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New("invalid action configuration"))
+	}
+
+	u := &unstructured.Unstructured{
+		Object: a.Cfg.Resource,
+	}
+
+	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	timeout := defaultWaitTimeout
+	if a.Cfg.Timeout != "" {
+		// timeout format is validated
+		timeout, _ = time.ParseDuration(a.Cfg.Timeout)
+	}
+
+	ctx, cancel := context.WithTimeout(a.kymaConfig.Ctx, timeout)
+	defer cancel()
+
+	watcher, err := client.RootlessDynamic().WatchSingleResource(ctx, u)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to watch resource"))
+	}
+	defer watcher.Stop()
+
+	if a.Cfg.ShowProgress {
+		out.Msgfln("waiting for %s %s", strings.ToLower(u.GetKind()), u.GetName())
+	}
+
+	clierr = waitForState(ctx, watcher, a.Cfg.buildStateChecks(), a.Cfg.ShowProgress)
+	if clierr != nil {
+		return clierror.WrapE(clierr, clierror.New(fmt.Sprintf("failed to wait for resource %s", u.GetName())))
+	}
+
+	if a.Cfg.OutputMessage != "" {
+		out.Msgln(a.Cfg.OutputMessage)
+	}
+
+	return nil
+}
+
+// waitForState reads events until the resource is in the Ready state or returns error if the resource is in the Error state
+func waitForState(ctx context.Context, watcher watch.Interface, checks []kyma.CustomStateCheck, showProgress bool) clierror.Error {
+	lastProgress := ""
+	for {
+		select {
+		case <-ctx.Done():
+			return clierror.Wrap(ctx.Err(), clierror.New("timeout reached while waiting for the resource",
+				"increase the timeout or check the resource status"))
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return clierror.New("watch closed unexpectedly")
+			}
+
+			switch event.Type {
+			case watch.Deleted:
+				return clierror.New("resource was deleted")
+			case watch.Error:
+				return clierror.New(fmt.Sprintf("watch error: %v", event.Object))
+			}
+
+			u, ok := event.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+
+			state, values := evaluateStateChecks(u.Object, checks)
+			if progress := strings.Join(values, ", "); showProgress && progress != lastProgress {
+				out.Msgfln("  %s", progress)
+				lastProgress = progress
+			}
+
+			switch state {
+			case readyState:
+				return nil
+			case errorState:
+				return clierror.New(fmt.Sprintf("resource is in the Error state (%s)", strings.Join(values, ", ")))
+			}
+		}
+	}
+}
+
+// evaluateStateChecks returns Error if any of the Error checks matches, Ready if all Ready checks match or empty state otherwise
+// the state is never Ready if there are no Ready checks
+// it returns values found under checks paths as well
+func evaluateStateChecks(obj map[string]interface{}, checks []kyma.CustomStateCheck) (string, []string) {
+	values := []string{}
+	readyChecks := 0
+	readyMatches := 0
+	isError := false
+	for _, check := range checks {
+		value := getJSONPathValue(obj, check.JSONPath)
+		values = append(values, fmt.Sprintf("%s: %s", check.JSONPath, value))
+
+		matches := value == check.Value
+		if check.MappedState == errorState && matches {
+			isError = true
+		}
+		if check.MappedState == readyState {
+			readyChecks++
+			if matches {
+				readyMatches++
+			}
+		}
+	}
+
+	if isError {
+		return errorState, values
+	}
+	if readyChecks > 0 && readyMatches == readyChecks {
+		return readyState, values
+	}
+
+	return "", values
+}
+
+// getJSONPathValue returns first value found under the jq path, path can be in the CustomStateCheck format without leading dot
+func getJSONPathValue(obj map[string]interface{}, path string) string {
+	if !strings.HasPrefix(path, ".") {
+		path = "." + path
+	}
+
+	query, err := gojq.Parse(path)
+	if err != nil {
+		return ""
+	}
+
+	value, ok := query.Run(obj).Next()
+	if _, isError := value.(error); !ok || isError || value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", value)
+}
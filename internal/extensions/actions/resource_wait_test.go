@@ -0,0 +1,158 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func Test_waitForState(t *testing.T) {
+	checks := (&resourceWaitActionConfig{
+		Condition: "Ready",
+		StateChecks: []kyma.CustomStateCheck{
+			{
+				JSONPath:    "status.state",
+				Value:       "Error",
+				MappedState: "Error",
+			},
+		},
+	}).buildStateChecks()
+
+	t.Run("wait for ready condition", func(t *testing.T) {
+		watcher := watch.NewFake()
+		go func() {
+			watcher.Add(fixWaitResource("Processing", "False"))
+			watcher.Modify(fixWaitResource("Ready", "True"))
+		}()
+
+		clierr := waitForState(context.Background(), watcher, checks, false)
+		require.Nil(t, clierr)
+	})
+
+	t.Run("fail on error state", func(t *testing.T) {
+		watcher := watch.NewFake()
+		go watcher.Add(fixWaitResource("Error", "False"))
+
+		clierr := waitForState(context.Background(), watcher, checks, false)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "resource is in the Error state")
+	})
+
+	t.Run("fail on deleted resource", func(t *testing.T) {
+		watcher := watch.NewFake()
+		go watcher.Delete(fixWaitResource("Processing", "False"))
+
+		clierr := waitForState(context.Background(), watcher, checks, false)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "resource was deleted")
+	})
+
+	t.Run("keep waiting with only error checks", func(t *testing.T) {
+		watcher := watch.NewFake()
+		go watcher.Add(fixWaitResource("Processing", "True"))
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		clierr := waitForState(ctx, watcher, []kyma.CustomStateCheck{
+			{JSONPath: "status.state", Value: "Error", MappedState: "Error"},
+		}, false)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "timeout reached while waiting for the resource")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		watcher := watch.NewFake()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		clierr := waitForState(ctx, watcher, checks, false)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "timeout reached while waiting for the resource")
+	})
+}
+
+func Test_resourceWaitActionConfig_validate(t *testing.T) {
+	t.Run("only error checks", func(t *testing.T) {
+		cfg := &resourceWaitActionConfig{
+			Resource: fixWaitResource("", "").Object,
+			StateChecks: []kyma.CustomStateCheck{
+				{JSONPath: "status.state", Value: "Error", MappedState: "Error"},
+			},
+		}
+
+		clierr := cfg.validate()
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "at least one check must be mapped to the Ready state")
+	})
+
+	t.Run("ready and error checks", func(t *testing.T) {
+		cfg := &resourceWaitActionConfig{
+			Resource:  fixWaitResource("", "").Object,
+			Condition: "Ready",
+			StateChecks: []kyma.CustomStateCheck{
+				{JSONPath: "status.state", Value: "Error", MappedState: "Error"},
+			},
+		}
+
+		require.Nil(t, cfg.validate())
+	})
+}
+
+func Test_evaluateStateChecks(t *testing.T) {
+	t.Run("not ready without ready checks", func(t *testing.T) {
+		state, values := evaluateStateChecks(fixWaitResource("Processing", "True").Object, []kyma.CustomStateCheck{
+			{JSONPath: "status.state", Value: "Error", MappedState: "Error"},
+		})
+
+		require.Equal(t, "", state)
+		require.Equal(t, []string{"status.state: Processing"}, values)
+	})
+
+	t.Run("not ready when one of ready checks does not match", func(t *testing.T) {
+		state, values := evaluateStateChecks(fixWaitResource("Ready", "False").Object, []kyma.CustomStateCheck{
+			{JSONPath: ".status.state", Value: "Ready", MappedState: "Ready"},
+			{JSONPath: `.status.conditions[] | select(.type == "Ready") | .status`, Value: "True", MappedState: "Ready"},
+		})
+
+		require.Equal(t, "", state)
+		require.Equal(t, []string{
+			".status.state: Ready",
+			`.status.conditions[] | select(.type == "Ready") | .status: False`,
+		}, values)
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		state, values := evaluateStateChecks(map[string]interface{}{}, []kyma.CustomStateCheck{
+			{JSONPath: "status.state", Value: "Ready", MappedState: "Ready"},
+		})
+
+		require.Equal(t, "", state)
+		require.Equal(t, []string{"status.state: "}, values)
+	})
+}
+
+func fixWaitResource(state, readyStatus string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "serverless.kyma-project.io/v1alpha2",
+			"kind":       "Function",
+			"metadata": map[string]interface{}{
+				"name": "test",
+			},
+			"status": map[string]interface{}{
+				"state": state,
+				"conditions": []interface{}{
+					map[string]interface{}{
+						"type":   "Ready",
+						"status": readyStatus,
+					},
+				},
+			},
+		},
+	}
+}
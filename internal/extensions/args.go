@@ -1,6 +1,9 @@
 package extensions
 
 import (
+	"math"
+	"slices"
+
 	"github.com/kyma-project/cli.v3/internal/extensions/errors"
 	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
@@ -37,6 +40,10 @@ func buildArgs(extensionArgs *types.Args, overwrites map[string]interface{}) arg
 				}
 			}
 
+			if slices.Contains(parameters.ArrayTypes, extensionArgs.Type) {
+				return setArrayArgs(value, extensionArgs, args)
+			}
+
 			if extensionArgs.Optional {
 				return setOptionalArg(value, args)
 			}
@@ -64,3 +71,42 @@ func setRequiredArg(value parameters.Value, args []string) error {
 
 	return value.Set(args[0])
 }
+
+func setArrayArgs(value parameters.Value, extensionArgs *types.Args, args []string) error {
+	minCount, maxCount := getArgsCountRange(extensionArgs)
+	if len(args) < minCount {
+		return errors.Newf("requires at least %d argument(s), received %d", minCount, len(args))
+	}
+
+	if len(args) > maxCount {
+		return errors.Newf("accepts at most %d argument(s), received %d", maxCount, len(args))
+	}
+
+	for _, arg := range args {
+		err := value.Set(arg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getArgsCountRange returns the number of accepted args for array types
+// by default required args accept at least one arg and there is no upper limit
+func getArgsCountRange(extensionArgs *types.Args) (int, int) {
+	minCount := 1
+	if extensionArgs.Optional {
+		minCount = 0
+	}
+	if extensionArgs.MinCount != nil {
+		minCount = *extensionArgs.MinCount
+	}
+
+	maxCount := math.MaxInt32
+	if extensionArgs.MaxCount != nil {
+		maxCount = *extensionArgs.MaxCount
+	}
+
+	return minCount, maxCount
+}
@@ -124,4 +124,51 @@ func Test_buildArgs(t *testing.T) {
 		require.NoError(t, err)
 		require.Empty(t, testArgs.value.GetValue())
 	})
+
+	t.Run("set array args", func(t *testing.T) {
+		testArgs := buildArgs(&types.Args{
+			Type: parameters.IntArrayCustomType,
+		}, fixEmptyOverwrites())
+
+		err := testArgs.run(&cobra.Command{}, []string{"1", "2", "3"})
+
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{int64(1), int64(2), int64(3)}, testArgs.value.GetValue())
+	})
+
+	t.Run("required array args with no given values", func(t *testing.T) {
+		testArgs := buildArgs(&types.Args{
+			Type: parameters.StringArrayCustomType,
+		}, fixEmptyOverwrites())
+
+		err := testArgs.run(&cobra.Command{}, []string{})
+
+		require.ErrorContains(t, err, "requires at least 1 argument(s), received 0")
+	})
+
+	t.Run("optional array args with no given values", func(t *testing.T) {
+		testArgs := buildArgs(&types.Args{
+			Type:     parameters.StringArrayCustomType,
+			Optional: true,
+		}, fixEmptyOverwrites())
+
+		err := testArgs.run(&cobra.Command{}, []string{})
+
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{}, testArgs.value.GetValue())
+	})
+
+	t.Run("array args out of count range", func(t *testing.T) {
+		testArgs := buildArgs(&types.Args{
+			Type:     parameters.StringArrayCustomType,
+			MinCount: toPtr(2),
+			MaxCount: toPtr(3),
+		}, fixEmptyOverwrites())
+
+		err := testArgs.run(&cobra.Command{}, []string{"a"})
+		require.ErrorContains(t, err, "requires at least 2 argument(s), received 1")
+
+		err = testArgs.run(&cobra.Command{}, []string{"a", "b", "c", "d"})
+		require.ErrorContains(t, err, "accepts at most 3 argument(s), received 4")
+	})
 }
@@ -0,0 +1,236 @@
+package extensions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/cmd/version"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// env overriding the time after which cached cluster extensions are revalidated (for example "30m"), "0" disables the cache
+	ExtensionsCacheTTLEnv = "KYMA_EXTENSIONS_CACHE_TTL"
+
+	defaultCacheTTL     = 10 * time.Minute
+	revalidationTimeout = 5 * time.Second
+)
+
+// clusterExtensionsCache contains extensions parsed from the cluster identified by the server and kubeconfig context
+type clusterExtensionsCache struct {
+	// version of the CLI that created the cache, cache from other versions is ignored
+	CLIVersion string `yaml:"cliVersion"`
+	Server     string `yaml:"server"`
+	Context    string `yaml:"context"`
+	// trust policy used to verify extensions, cache verified with other policy is ignored
+	TrustPolicy string `yaml:"trustPolicy"`
+	// time of the last fetch or successful revalidation
+	ValidatedAt time.Time `yaml:"validatedAt"`
+	// resourceVersions of all extension configmaps in format <namespace>/<name>: <resourceVersion>
+	ResourceVersions map[string]string                 `yaml:"resourceVersions"`
+	Extensions       []types.ConfigmapCommandExtension `yaml:"extensions"`
+	// configmaps refused by the trust policy or invalid
+	Rejected []RejectedExtension `yaml:"rejected,omitempty"`
+	// errors that occurred while parsing configmaps
+	Error string `yaml:"error,omitempty"`
+}
+
+// loadCommandExtensionsFromClusterWithCache returns extensions for the current cluster and context from the cache if it exists
+// fetched extensions are used until the cache TTL expires, then the cache is still used but revalidated in the background
+// so changes in the cluster are visible in the next run
+func (b *Builder) loadCommandExtensionsFromClusterWithCache(ctx context.Context, client kube.Client) ([]types.ConfigmapCommandExtension, error) {
+	ttl := getCacheTTL()
+	cachePath := getCacheFilePath(client)
+	if ttl <= 0 || cachePath == "" {
+		// cache is disabled or the cluster can't be identified
+		extensions, rejected, err := loadCommandExtensionsFromCluster(ctx, client)
+		b.rejectedExtensions = rejected
+		return extensions, err
+	}
+
+	if !getBoolFlagValue("--refresh-extensions") {
+		cache, err := readCache(cachePath, client)
+		if err == nil {
+			if time.Since(cache.ValidatedAt) > ttl {
+				b.revalidateCache(ctx, client, cachePath, cache)
+			}
+
+			b.rejectedExtensions = cache.Rejected
+			return cache.Extensions, cache.parseError()
+		}
+	}
+
+	cms, err := listCommandExtenionConfigMaps(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := newClusterExtensionsCache(client, cms)
+	// cache is optional and the command works without it
+	_ = writeCache(cachePath, cache)
+
+	b.rejectedExtensions = cache.Rejected
+	return cache.Extensions, cache.parseError()
+}
+
+// revalidateCache compares resourceVersions of configmaps in the cluster with the cached ones and updates the cache in the background
+// use the Wait func to wait until it's done
+func (b *Builder) revalidateCache(ctx context.Context, client kube.Client, cachePath string, cache *clusterExtensionsCache) {
+	if b.revalidation == nil {
+		b.revalidation = &sync.WaitGroup{}
+	}
+
+	b.revalidation.Add(1)
+	go func() {
+		defer b.revalidation.Done()
+
+		ctx, cancel := context.WithTimeout(ctx, revalidationTimeout)
+		defer cancel()
+
+		cms, err := listCommandExtenionConfigMaps(ctx, client)
+		if err != nil {
+			// keep expired cache (for example when working offline) and try again in the next run
+			return
+		}
+
+		if maps.Equal(cache.ResourceVersions, configMapsResourceVersions(cms)) {
+			cache.ValidatedAt = time.Now()
+			_ = writeCache(cachePath, cache)
+			return
+		}
+
+		_ = writeCache(cachePath, newClusterExtensionsCache(client, cms))
+	}()
+}
+
+func newClusterExtensionsCache(client kube.Client, cms *v1.ConfigMapList) *clusterExtensionsCache {
+	extensions, rejected, err := parseCommandExtensionConfigMaps(cms)
+
+	cache := &clusterExtensionsCache{
+		CLIVersion:       version.GetVersion(),
+		Server:           client.RestConfig().Host,
+		Context:          client.APIConfig().CurrentContext,
+		TrustPolicy:      currentTrustPolicy(),
+		ValidatedAt:      time.Now(),
+		ResourceVersions: configMapsResourceVersions(cms),
+		Extensions:       extensions,
+		Rejected:         rejected,
+	}
+	if err != nil {
+		cache.Error = err.Error()
+	}
+
+	return cache
+}
+
+func (c *clusterExtensionsCache) parseError() error {
+	if c.Error == "" {
+		return nil
+	}
+
+	return errors.New(c.Error)
+}
+
+func configMapsResourceVersions(cms *v1.ConfigMapList) map[string]string {
+	versions := map[string]string{}
+	for _, cm := range cms.Items {
+		versions[cm.GetNamespace()+"/"+cm.GetName()] = cm.GetResourceVersion()
+	}
+
+	return versions
+}
+
+func readCache(path string, client kube.Client) (*clusterExtensionsCache, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &clusterExtensionsCache{}
+	err = yaml.Unmarshal(data, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	if cache.CLIVersion != version.GetVersion() ||
+		cache.Server != client.RestConfig().Host ||
+		cache.Context != client.APIConfig().CurrentContext ||
+		cache.TrustPolicy != currentTrustPolicy() {
+		return nil, errors.Newf("cache file '%s' is outdated", path)
+	}
+
+	return cache, nil
+}
+
+func writeCache(path string, cache *clusterExtensionsCache) error {
+	data, err := yaml.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	// write to the temporary file first to not leave broken cache when the process is interrupted
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+// returns path to the cache file of the current cluster server and context or empty string if it's not possible to identify them
+func getCacheFilePath(client kube.Client) string {
+	if client.RestConfig() == nil || client.APIConfig() == nil {
+		return ""
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	key := sha256.Sum256([]byte(client.RestConfig().Host + "\n" + client.APIConfig().CurrentContext))
+	return filepath.Join(homeDir, ".kyma", "cache", "extensions", hex.EncodeToString(key[:])+".yaml")
+}
+
+func getCacheTTL() time.Duration {
+	ttl, err := time.ParseDuration(os.Getenv(ExtensionsCacheTTLEnv))
+	if err != nil {
+		return defaultCacheTTL
+	}
+
+	return ttl
+}
+
+// returns identifier of the current trust policy or empty string if it can't be loaded
+func currentTrustPolicy() string {
+	policy, err := loadTrustPolicy()
+	if err != nil {
+		return ""
+	}
+
+	return policy.String()
+}
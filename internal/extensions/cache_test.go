@@ -0,0 +1,172 @@
+package extensions
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func Test_loadCommandExtensionsFromClusterWithCache(t *testing.T) {
+	t.Run("fetch extensions and write cache", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsCacheTTLEnv, "")
+		client := fixCacheKubeClient(fixVersionedExtensionConfigMap("cm1", "1", testExtensionString))
+
+		b := Builder{}
+		extensions, err := b.loadCommandExtensionsFromClusterWithCache(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, []types.ConfigmapCommandExtension{
+			{
+				ConfigMapName:      "cm1",
+				ConfigMapNamespace: "kyma-system",
+				Extension:          testExtension,
+			},
+		}, extensions)
+
+		cache, err := readCache(getCacheFilePath(client), client)
+		require.NoError(t, err)
+		require.Equal(t, "https://cluster", cache.Server)
+		require.Equal(t, "test-context", cache.Context)
+		require.Equal(t, map[string]string{"kyma-system/cm1": "1"}, cache.ResourceVersions)
+		require.Len(t, cache.Extensions, 1)
+		require.Equal(t, "cm1", cache.Extensions[0].ConfigMapName)
+		require.Equal(t, "resource", cache.Extensions[0].Extension.Metadata.Name)
+	})
+
+	t.Run("use fresh cache without calling cluster", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsCacheTTLEnv, "")
+		client := fixCacheKubeClient(fixVersionedExtensionConfigMap("cm1", "1", testExtensionString))
+		cache := newClusterExtensionsCache(client, &corev1.ConfigMapList{})
+		cache.Error = "parse error"
+		require.NoError(t, writeCache(getCacheFilePath(client), cache))
+
+		b := Builder{}
+		extensions, err := b.loadCommandExtensionsFromClusterWithCache(context.Background(), client)
+		b.Wait()
+		require.Equal(t, errors.New("parse error"), err)
+		require.Empty(t, extensions)
+		require.Empty(t, client.TestKubernetesInterface.(*fake.Clientset).Actions())
+	})
+
+	t.Run("refresh cache on flag", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsCacheTTLEnv, "")
+		oldArgs := os.Args
+		os.Args = append(os.Args, "--refresh-extensions")
+		defer func() { os.Args = oldArgs }()
+
+		client := fixCacheKubeClient(fixVersionedExtensionConfigMap("cm1", "1", testExtensionString))
+		require.NoError(t, writeCache(getCacheFilePath(client), newClusterExtensionsCache(client, &corev1.ConfigMapList{})))
+
+		b := Builder{}
+		extensions, err := b.loadCommandExtensionsFromClusterWithCache(context.Background(), client)
+		require.NoError(t, err)
+		require.Len(t, extensions, 1)
+	})
+
+	t.Run("ignore cache of other context", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsCacheTTLEnv, "")
+		client := fixCacheKubeClient(fixVersionedExtensionConfigMap("cm1", "1", testExtensionString))
+		otherClient := fixCacheKubeClient()
+		otherClient.TestAPIConfig.CurrentContext = "other-context"
+		// write cache of other context under the current context path
+		require.NoError(t, writeCache(getCacheFilePath(client), newClusterExtensionsCache(otherClient, &corev1.ConfigMapList{})))
+
+		b := Builder{}
+		extensions, err := b.loadCommandExtensionsFromClusterWithCache(context.Background(), client)
+		require.NoError(t, err)
+		require.Len(t, extensions, 1)
+	})
+
+	t.Run("disable cache", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsCacheTTLEnv, "0")
+		client := fixCacheKubeClient(fixVersionedExtensionConfigMap("cm1", "1", testExtensionString))
+
+		b := Builder{}
+		extensions, err := b.loadCommandExtensionsFromClusterWithCache(context.Background(), client)
+		require.NoError(t, err)
+		require.Len(t, extensions, 1)
+		require.NoFileExists(t, getCacheFilePath(client))
+	})
+
+	t.Run("revalidate expired cache with unchanged configmaps", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsCacheTTLEnv, "1m")
+		client := fixCacheKubeClient(fixVersionedExtensionConfigMap("cm1", "1", testExtensionString))
+		cache := newClusterExtensionsCache(client, &corev1.ConfigMapList{
+			Items: []corev1.ConfigMap{*fixVersionedExtensionConfigMap("cm1", "1", testExtensionString)},
+		})
+		cache.ValidatedAt = time.Now().Add(-time.Hour)
+		require.NoError(t, writeCache(getCacheFilePath(client), cache))
+
+		b := Builder{}
+		extensions, err := b.loadCommandExtensionsFromClusterWithCache(context.Background(), client)
+		require.NoError(t, err)
+		require.Len(t, extensions, 1)
+
+		b.Wait()
+		revalidatedCache, err := readCache(getCacheFilePath(client), client)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now(), revalidatedCache.ValidatedAt, time.Minute)
+		require.Len(t, revalidatedCache.Extensions, 1)
+	})
+
+	t.Run("revalidate expired cache with changed configmaps", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsCacheTTLEnv, "1m")
+		client := fixCacheKubeClient(fixVersionedExtensionConfigMap("cm1", "2", testExtensionString))
+		cache := newClusterExtensionsCache(client, &corev1.ConfigMapList{})
+		cache.ValidatedAt = time.Now().Add(-time.Hour)
+		require.NoError(t, writeCache(getCacheFilePath(client), cache))
+
+		b := Builder{}
+		extensions, err := b.loadCommandExtensionsFromClusterWithCache(context.Background(), client)
+		require.NoError(t, err)
+		// expired cache is used in the current run
+		require.Empty(t, extensions)
+
+		b.Wait()
+		revalidatedCache, err := readCache(getCacheFilePath(client), client)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"kyma-system/cm1": "2"}, revalidatedCache.ResourceVersions)
+		require.Len(t, revalidatedCache.Extensions, 1)
+		require.Equal(t, "cm1", revalidatedCache.Extensions[0].ConfigMapName)
+		require.Equal(t, "resource", revalidatedCache.Extensions[0].Extension.Metadata.Name)
+	})
+}
+
+func fixCacheKubeClient(objects ...*corev1.ConfigMap) *kubefake.KubeClient {
+	clientset := fake.NewClientset()
+	for _, obj := range objects {
+		_ = clientset.Tracker().Add(obj)
+	}
+
+	return &kubefake.KubeClient{
+		TestKubernetesInterface: clientset,
+		TestRestConfig: &rest.Config{
+			Host: "https://cluster",
+		},
+		TestAPIConfig: &api.Config{
+			CurrentContext: "test-context",
+		},
+	}
+}
+
+func fixVersionedExtensionConfigMap(name, resourceVersion, data string) *corev1.ConfigMap {
+	cm := fixTestExtensionConfigMap(name, data)
+	cm.ResourceVersion = resourceVersion
+	return cm
+}
@@ -0,0 +1,43 @@
+package call
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+)
+
+// HostCaller sends requests directly to the host, for example, exposed by the APIRule
+type HostCaller struct {
+	client  *http.Client
+	address string
+}
+
+// DefaultHostCallTimeout is used if the timeout of the request sent to the host is not set
+const DefaultHostCallTimeout = 30 * time.Second
+
+func NewHostCaller(address string, timeout time.Duration) *HostCaller {
+	if timeout <= 0 {
+		timeout = DefaultHostCallTimeout
+	}
+
+	return &HostCaller{
+		client:  &http.Client{Timeout: timeout},
+		address: address,
+	}
+}
+
+func (c *HostCaller) Do(request Request) ([]byte, clierror.Error) {
+	req, err := buildRequest(c.address, request)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to build request"))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to send request to the host"))
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse(resp)
+}
@@ -0,0 +1,37 @@
+package call
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostCaller_Do(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	t.Run("join path to the address path", func(t *testing.T) {
+		resp, clierr := NewHostCaller(server.URL+"/api/v1", 0).Do(Request{
+			Path:       "/users",
+			Parameters: map[string]string{"limit": "10"},
+		})
+		require.Nil(t, clierr)
+		require.Equal(t, []byte("ok"), resp)
+
+		req := <-requests
+		require.Equal(t, "/api/v1/users", req.URL.Path)
+		require.Equal(t, "limit=10", req.URL.RawQuery)
+	})
+
+	t.Run("use address path without request path", func(t *testing.T) {
+		_, clierr := NewHostCaller(server.URL+"/api/v1/users", 0).Do(Request{})
+		require.Nil(t, clierr)
+		require.Equal(t, "/api/v1/users", (<-requests).URL.Path)
+	})
+}
@@ -1,22 +1,44 @@
 package call
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
+	"path"
+	"strconv"
+	"strings"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/kube/resources"
 	"github.com/kyma-project/cli.v3/internal/portforward"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// Request contains all data needed to build the HTTP request
+type Request struct {
+	Method     string
+	Path       string
+	Parameters map[string]string
+	Headers    map[string]string
+	Body       []byte
+}
+
+type Caller interface {
+	Do(Request) ([]byte, clierror.Error)
+}
+
 type PodCaller struct {
 	ctx          context.Context
 	client       kube.Client
 	podSelector  map[string]string
 	podNamespace string
 	podPort      string
+	// optional name of the service used to find the target Pod and port
+	serviceName string
 }
 
 func NewPodCaller(ctx context.Context, client kube.Client, podNamespace string, podSelector map[string]string, podPort string) *PodCaller {
@@ -29,18 +51,53 @@ func NewPodCaller(ctx context.Context, client kube.Client, podNamespace string,
 	}
 }
 
+// NewServiceCaller returns caller sending requests to the Pod selected by the service
+// the servicePort can be the port number or name and can be empty if the service has only one port
+func NewServiceCaller(ctx context.Context, client kube.Client, serviceNamespace, serviceName, servicePort string) *PodCaller {
+	return &PodCaller{
+		ctx:          ctx,
+		client:       client,
+		podNamespace: serviceNamespace,
+		podPort:      servicePort,
+		serviceName:  serviceName,
+	}
+}
+
 func (c *PodCaller) Call(method, path string, parameters map[string]string) ([]byte, clierror.Error) {
+	return c.Do(Request{
+		Method:     method,
+		Path:       path,
+		Parameters: parameters,
+	})
+}
+
+func (c *PodCaller) Do(request Request) ([]byte, clierror.Error) {
+	podSelector, podPort := c.podSelector, intstr.Parse(c.podPort)
+	if c.serviceName != "" {
+		var err error
+		podSelector, podPort, err = getServiceTarget(c.ctx, c.client, c.podNamespace, c.serviceName, c.podPort)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to get target Service"))
+		}
+	}
+
 	targetPod, err := resources.GetPodForSelector(
 		c.ctx,
 		c.client.Static(),
 		c.podNamespace,
-		c.podSelector,
+		podSelector,
 	)
 	if err != nil {
 		return nil, clierror.Wrap(err, clierror.New("failed to get target Pod"))
 	}
 
-	req, err := buildRequest(method, targetPod.GetName(), targetPod.GetNamespace(), c.podPort, path, parameters)
+	targetPort, err := resolvePodPort(targetPod, podPort)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to get target Pod port"))
+	}
+
+	address := fmt.Sprintf("http://%s.%s.svc.cluster.local:%s", targetPod.GetName(), targetPod.GetNamespace(), targetPort)
+	req, err := buildRequest(address, request)
 	if err != nil {
 		return nil, clierror.Wrap(err, clierror.New("failed to build request"))
 	}
@@ -49,7 +106,7 @@ func (c *PodCaller) Call(method, path string, parameters map[string]string) ([]b
 		c.client.RestConfig(),
 		targetPod.GetName(),
 		targetPod.GetNamespace(),
-		c.podPort,
+		targetPort,
 		req,
 	)
 	if err != nil {
@@ -60,20 +117,80 @@ func (c *PodCaller) Call(method, path string, parameters map[string]string) ([]b
 	return decodeResponse(resp)
 }
 
-func buildRequest(method, podName, podNamespace, podPort, path string, parameters map[string]string) (*http.Request, error) {
-	address := fmt.Sprintf("http://%s.%s.svc.cluster.local:%s", podName, podNamespace, podPort)
-	req, err := http.NewRequest(method, address, nil)
+// getServiceTarget returns Pods selector and target port of the service port with given number or name
+func getServiceTarget(ctx context.Context, client kube.Client, namespace, name, port string) (map[string]string, intstr.IntOrString, error) {
+	svc, err := client.Static().CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, intstr.IntOrString{}, err
+	}
+
+	if len(svc.Spec.Selector) == 0 {
+		return nil, intstr.IntOrString{}, fmt.Errorf("service %s/%s has no Pod selector", namespace, name)
+	}
+
+	for _, svcPort := range svc.Spec.Ports {
+		if (port == "" && len(svc.Spec.Ports) == 1) ||
+			port == strconv.Itoa(int(svcPort.Port)) || port == svcPort.Name {
+			targetPort := svcPort.TargetPort
+			if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+				// target port is not set and is the same as the port
+				targetPort = intstr.FromInt32(svcPort.Port)
+			}
+
+			return svc.Spec.Selector, targetPort, nil
+		}
+	}
+
+	return nil, intstr.IntOrString{}, fmt.Errorf("port '%s' not found in service %s/%s", port, namespace, name)
+}
+
+// resolvePodPort returns port number for the port name defined in Pod containers
+func resolvePodPort(pod *corev1.Pod, port intstr.IntOrString) (string, error) {
+	if port.Type == intstr.Int {
+		return port.String(), nil
+	}
+
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == port.StrVal {
+				return strconv.Itoa(int(containerPort.ContainerPort)), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("port '%s' not found in Pod %s/%s", port.StrVal, pod.GetNamespace(), pod.GetName())
+}
+
+func buildRequest(address string, request Request) (*http.Request, error) {
+	method := request.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequest(method, address, bytes.NewReader(request.Body))
 	if err != nil {
 		return nil, err
 	}
 
-	req.URL.Path = path
+	if request.Path != "" {
+		// join the path to the address path, for example, to the API version in the host address
+		joinedPath := path.Join("/", req.URL.Path, request.Path)
+		if strings.HasSuffix(request.Path, "/") && joinedPath != "/" {
+			joinedPath += "/"
+		}
+		req.URL.Path = joinedPath
+	}
 
 	query := req.URL.Query()
-	for k, v := range parameters {
+	for k, v := range request.Parameters {
 		query.Add(k, v)
 	}
 
 	req.URL.RawQuery = query.Encode()
+
+	for k, v := range request.Headers {
+		req.Header.Set(k, v)
+	}
+
 	return req, nil
 }
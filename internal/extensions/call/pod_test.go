@@ -0,0 +1,130 @@
+package call
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_getServiceTarget(t *testing.T) {
+	client := &kubefake.KubeClient{
+		TestKubernetesInterface: k8sfake.NewClientset(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc",
+				Namespace: "default",
+			},
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{"app": "test"},
+				Ports: []corev1.ServicePort{
+					{
+						Name: "http",
+						Port: 80,
+						// target port not set
+					},
+					{
+						Name:       "metrics",
+						Port:       9090,
+						TargetPort: intstr.FromString("metrics-port"),
+					},
+				},
+			},
+		}),
+	}
+
+	t.Run("get target by port number", func(t *testing.T) {
+		selector, port, err := getServiceTarget(context.Background(), client, "default", "svc", "80")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"app": "test"}, selector)
+		require.Equal(t, intstr.FromInt32(80), port)
+	})
+
+	t.Run("get target by port name", func(t *testing.T) {
+		_, port, err := getServiceTarget(context.Background(), client, "default", "svc", "metrics")
+		require.NoError(t, err)
+		require.Equal(t, intstr.FromString("metrics-port"), port)
+	})
+
+	t.Run("port not found", func(t *testing.T) {
+		_, _, err := getServiceTarget(context.Background(), client, "default", "svc", "")
+		require.EqualError(t, err, "port '' not found in service default/svc")
+	})
+
+	t.Run("service not found", func(t *testing.T) {
+		_, _, err := getServiceTarget(context.Background(), client, "default", "missing", "80")
+		require.EqualError(t, err, "services \"missing\" not found")
+	})
+}
+
+func Test_resolvePodPort(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod",
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Ports: []corev1.ContainerPort{
+						{Name: "metrics-port", ContainerPort: 9091},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("resolve port number", func(t *testing.T) {
+		port, err := resolvePodPort(pod, intstr.FromInt32(8080))
+		require.NoError(t, err)
+		require.Equal(t, "8080", port)
+	})
+
+	t.Run("resolve port name", func(t *testing.T) {
+		port, err := resolvePodPort(pod, intstr.FromString("metrics-port"))
+		require.NoError(t, err)
+		require.Equal(t, "9091", port)
+	})
+
+	t.Run("port name not found", func(t *testing.T) {
+		_, err := resolvePodPort(pod, intstr.FromString("http"))
+		require.EqualError(t, err, "port 'http' not found in Pod default/pod")
+	})
+}
+
+func Test_buildRequest(t *testing.T) {
+	t.Run("build request", func(t *testing.T) {
+		req, err := buildRequest("http://pod.default.svc.cluster.local:8080", Request{
+			Method:     "POST",
+			Path:       "/api/items",
+			Parameters: map[string]string{"dryRun": "true"},
+			Headers:    map[string]string{"Content-Type": "application/json"},
+			Body:       []byte(`{"name":"test"}`),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "POST", req.Method)
+		require.Equal(t, "http://pod.default.svc.cluster.local:8080/api/items?dryRun=true", req.URL.String())
+		require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.Equal(t, `{"name":"test"}`, string(body))
+	})
+
+	t.Run("join path to the address path", func(t *testing.T) {
+		req, err := buildRequest("https://api.example.com/v1", Request{Path: "/users/"})
+		require.NoError(t, err)
+		require.Equal(t, "https://api.example.com/v1/users/", req.URL.String())
+	})
+
+	t.Run("use GET by default", func(t *testing.T) {
+		req, err := buildRequest("http://pod.default.svc.cluster.local:8080", Request{})
+		require.NoError(t, err)
+		require.Equal(t, "GET", req.Method)
+	})
+}
@@ -1,6 +1,8 @@
 package extensions
 
 import (
+	"fmt"
+
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/extensions/errors"
 	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
@@ -10,13 +12,18 @@ import (
 )
 
 var (
-	emptyActionRun       = func(cmd *cobra.Command, _ []string) error { return cmd.Help() }
-	unsupportedActionRun = func(_ *cobra.Command, _ []string) {
-		clierror.Check(clierror.New("unsupported action",
-			"ensure the CLI version is compatible with the extension"))
-	}
+	emptyActionRun = func(cmd *cobra.Command, _ []string) error { return cmd.Help() }
 )
 
+// newUnsupportedActionRun returns run informing user that the action is not supported by this CLI build
+func newUnsupportedActionRun(actionID string) func(*cobra.Command, []string) {
+	return func(_ *cobra.Command, _ []string) {
+		clierror.Check(clierror.New(fmt.Sprintf("unsupported action '%s'", actionID),
+			"upgrade Kyma CLI to the latest version",
+			"use the 'kyma extension versions' command to check compatibility of extensions"))
+	}
+}
+
 func buildCommand(extension types.Extension, availableActions types.ActionsMap) (*cobra.Command, error) {
 	var errs []error
 
@@ -48,6 +55,17 @@ func buildSingleCommand(extension types.Extension, availableActions types.Action
 		Long:  extension.Metadata.DescriptionLong,
 	}
 
+	if len(extension.Steps) != 0 {
+		// set flags and args used by all steps
+		cmdInputs, err := buildInputs(cmd, extension)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		setStepsRun(cmd, extension, cmdInputs, availableActions)
+		return cmd, errors.NewList(errs...)
+	}
+
 	if extension.Action == "" {
 		// no action provided
 		// set help command as default run
@@ -55,50 +73,32 @@ func buildSingleCommand(extension types.Extension, availableActions types.Action
 		return cmd, errors.NewList(errs...)
 	}
 
-	// set flags
-	overwrites := types.ActionConfigOverwrites{
-		"flags": map[string]interface{}{},
-	}
-	values := []parameters.Value{}
-	requiredFlags := []string{}
-	for _, extensionFlag := range extension.Flags {
-		cmdFlag := buildFlag(extensionFlag, overwrites)
-		if cmdFlag.warning != nil {
-			errs = append(errs, errors.Newf("flag '%s' error: %s", extensionFlag.Name, cmdFlag.warning.Error()))
-		}
-
-		if extensionFlag.Required {
-			requiredFlags = append(requiredFlags, extensionFlag.Name)
-		}
-
-		cmd.Flags().AddFlag(cmdFlag.pflag)
-		values = append(values, cmdFlag.value)
+	// set flags and args
+	cmdInputs, err := buildInputs(cmd, extension)
+	if err != nil {
+		errs = append(errs, err)
 	}
 
-	// set args
-	cmdArgs := buildArgs(extension.Args, overwrites)
-	cmd.Args = cmdArgs.run
-	values = append(values, cmdArgs.value)
-
 	// set action runs
 	action, ok := availableActions[extension.Action]
 	if !ok {
 		// action not found
 		// set unsupported action run to inform user
-		cmd.Run = unsupportedActionRun
+		cmd.Run = newUnsupportedActionRun(extension.Action)
 		return cmd, errors.NewList(errs...)
 	}
 
 	cmd.PreRun = func(_ *cobra.Command, _ []string) {
-		// check required flags
+		// ask for missing flags values in the interactive terminal
+		clierror.Check(cmdInputs.promptMissingFlags(cmd.Flags()))
+
+		// check required flags and flags values
 		clierror.Check(flags.Validate(cmd.Flags(),
-			flags.MarkRequired(requiredFlags...),
+			append([]flags.Rule{flags.MarkRequired(cmdInputs.requiredFlags...)}, cmdInputs.flagRules...)...,
 		))
-		// set parameters from flag and args as overwrites
-		clierror.Check(parameters.Set(overwrites, values))
 
-		// configure action
-		clierror.Check(action.Configure(extension.Config, overwrites))
+		// configure action with parameters from flag and args
+		clierror.Check(cmdInputs.configure(action, extension.Config))
 	}
 
 	cmd.Run = func(cmd *cobra.Command, args []string) {
@@ -108,3 +108,64 @@ func buildSingleCommand(extension types.Extension, availableActions types.Action
 
 	return cmd, errors.NewList(errs...)
 }
+
+type inputs struct {
+	overwrites    types.ActionConfigOverwrites
+	values        []parameters.Value
+	requiredFlags []string
+	promptFlags   []types.Flag
+	flagRules     []flags.Rule
+}
+
+// buildInputs adds extension flags and args to the command and returns overwrites that are set based on them
+func buildInputs(cmd *cobra.Command, extension types.Extension) (*inputs, error) {
+	var errs []error
+	cmdInputs := &inputs{
+		overwrites: types.ActionConfigOverwrites{
+			"flags": map[string]interface{}{},
+		},
+		values:        []parameters.Value{},
+		requiredFlags: []string{},
+		flagRules:     []flags.Rule{},
+	}
+
+	for _, extensionFlag := range extension.Flags {
+		cmdFlag := buildFlag(extensionFlag, cmdInputs.overwrites)
+		if cmdFlag.warning != nil {
+			errs = append(errs, errors.Newf("flag '%s' error: %s", extensionFlag.Name, cmdFlag.warning.Error()))
+		}
+
+		if extensionFlag.Required {
+			cmdInputs.requiredFlags = append(cmdInputs.requiredFlags, extensionFlag.Name)
+		}
+
+		if extensionFlag.Prompt {
+			cmdInputs.promptFlags = append(cmdInputs.promptFlags, extensionFlag)
+		}
+
+		cmd.Flags().AddFlag(cmdFlag.pflag)
+		cmdInputs.values = append(cmdInputs.values, cmdFlag.value)
+		cmdInputs.flagRules = append(cmdInputs.flagRules, buildFlagRule(extensionFlag))
+
+		err := registerFlagCompletion(cmd, extensionFlag)
+		if err != nil {
+			errs = append(errs, errors.Newf("flag '%s' error: %s", extensionFlag.Name, err.Error()))
+		}
+	}
+
+	cmdArgs := buildArgs(extension.Args, cmdInputs.overwrites)
+	cmd.Args = cmdArgs.run
+	cmdInputs.values = append(cmdInputs.values, cmdArgs.value)
+
+	return cmdInputs, errors.NewList(errs...)
+}
+
+// configure sets parameters from flags and args as overwrites and uses them to configure action
+func (i *inputs) configure(action types.Action, config types.ActionConfig) clierror.Error {
+	clierr := parameters.Set(i.overwrites, i.values)
+	if clierr != nil {
+		return clierr
+	}
+
+	return action.Configure(config, i.overwrites)
+}
@@ -0,0 +1,78 @@
+package extensions
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/kyma-project/cli.v3/internal/cmd/version"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+)
+
+const (
+	// extension schema version used when the apiVersion field is empty
+	DefaultExtensionAPIVersion = "v1"
+)
+
+// list of extension schema versions supported by this CLI build
+var supportedExtensionAPIVersions = []string{DefaultExtensionAPIVersion}
+
+// CheckCompatibility returns an error if the extension can't be run by this CLI build
+// because it uses unsupported schema version or requires newer CLI version
+func CheckCompatibility(extension types.Extension) error {
+	return checkCompatibility(extension, version.GetVersion())
+}
+
+// ExtensionAPIVersion returns the extension schema version or the default one if not set
+func ExtensionAPIVersion(extension types.Extension) string {
+	if extension.APIVersion == "" {
+		return DefaultExtensionAPIVersion
+	}
+
+	return extension.APIVersion
+}
+
+func checkCompatibility(extension types.Extension, cliVersion string) error {
+	apiVersion := ExtensionAPIVersion(extension)
+	if !slices.Contains(supportedExtensionAPIVersions, apiVersion) {
+		return errors.Newf("apiVersion '%s' is not supported (supported versions: %s), upgrade Kyma CLI to the latest version",
+			apiVersion, strings.Join(supportedExtensionAPIVersions, ", "))
+	}
+
+	if extension.MinCLIVersion == "" {
+		return nil
+	}
+
+	minVersion, err := semver.NewVersion(extension.MinCLIVersion)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse minCliVersion '%s'", extension.MinCLIVersion)
+	}
+
+	currentVersion, err := semver.NewVersion(cliVersion)
+	if err != nil {
+		// development builds (like 'local') are compatible with all extensions
+		return nil
+	}
+
+	// pre-release builds are compared as the final release
+	releaseVersion, _ := currentVersion.SetPrerelease("")
+	if releaseVersion.LessThan(minVersion) {
+		return errors.Newf("extension requires Kyma CLI version %s or higher (current version: %s), upgrade Kyma CLI to the latest version",
+			extension.MinCLIVersion, cliVersion)
+	}
+
+	return nil
+}
+
+// UnsupportedActions returns sorted IDs of actions used by the extension but not supported by this CLI build
+func UnsupportedActions(extension types.Extension, availableActions types.ActionsMap) []string {
+	unsupported := []string{}
+	for _, action := range ListActions(extension) {
+		if _, ok := availableActions[action]; !ok {
+			unsupported = append(unsupported, action)
+		}
+	}
+
+	return unsupported
+}
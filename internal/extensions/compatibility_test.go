@@ -0,0 +1,80 @@
+package extensions
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkCompatibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		extension  types.Extension
+		cliVersion string
+		wantErr    string
+	}{
+		{
+			name:       "extension without versions",
+			extension:  types.Extension{},
+			cliVersion: "3.0.0",
+		},
+		{
+			name: "supported apiVersion and minCliVersion",
+			extension: types.Extension{
+				APIVersion:    "v1",
+				MinCLIVersion: "3.1.0",
+			},
+			cliVersion: "3.1.0",
+		},
+		{
+			name: "pre-release version compared as release",
+			extension: types.Extension{
+				MinCLIVersion: "3.1.0",
+			},
+			cliVersion: "3.1.0-rc1",
+		},
+		{
+			name: "development build",
+			extension: types.Extension{
+				MinCLIVersion: "3.1.0",
+			},
+			cliVersion: "local",
+		},
+		{
+			name: "unsupported apiVersion",
+			extension: types.Extension{
+				APIVersion: "v2",
+			},
+			cliVersion: "3.1.0",
+			wantErr:    "apiVersion 'v2' is not supported (supported versions: v1), upgrade Kyma CLI to the latest version",
+		},
+		{
+			name: "too old CLI",
+			extension: types.Extension{
+				MinCLIVersion: "3.2.0",
+			},
+			cliVersion: "v3.1.5",
+			wantErr:    "extension requires Kyma CLI version 3.2.0 or higher (current version: v3.1.5), upgrade Kyma CLI to the latest version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCompatibility(tt.extension, tt.cliVersion)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_UnsupportedActions(t *testing.T) {
+	require.Equal(t, []string{"build", "cleanup"}, UnsupportedActions(fixStepsExtension(), types.ActionsMap{
+		"deploy": &mockAction{},
+	}))
+	require.Empty(t, UnsupportedActions(fixTestExtension(), types.ActionsMap{
+		"action1": &mockAction{},
+	}))
+}
@@ -7,10 +7,12 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
 	"github.com/kyma-project/cli.v3/internal/extensions/errors"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -19,9 +21,35 @@ import (
 )
 
 type Builder struct {
-	extensions       []types.ConfigmapCommandExtension
-	extensionsErrors []error
-	printer          *out.Printer
+	extensions         []types.ConfigmapCommandExtension
+	extensionsErrors   []error
+	extensionsStatuses []ExtensionStatus
+	// configmaps from the cluster that are not loaded
+	rejectedExtensions []RejectedExtension
+	printer            *out.Printer
+	revalidation       *sync.WaitGroup
+}
+
+// ExtensionStatus contains the extension and the result of building commands from it
+type ExtensionStatus struct {
+	types.ConfigmapCommandExtension
+
+	// error that occurred while building the extension, nil if the extension is loaded
+	Error error
+}
+
+// RejectedExtension describes the extension configmap from the cluster that is not loaded
+type RejectedExtension struct {
+	ConfigMapName      string `yaml:"configMapName"`
+	ConfigMapNamespace string `yaml:"configMapNamespace"`
+	// true if the configmap is refused by the trust policy, false if it's invalid
+	Refused bool `yaml:"refused"`
+	// reason why the configmap is not loaded
+	Reason string `yaml:"reason"`
+}
+
+func (r *RejectedExtension) Source() string {
+	return fmt.Sprintf("configmap '%s/%s'", r.ConfigMapNamespace, r.ConfigMapName)
 }
 
 func NewBuilder(kymaConfig *cmdcommon.KymaConfig) *Builder {
@@ -34,8 +62,18 @@ func NewBuilder(kymaConfig *cmdcommon.KymaConfig) *Builder {
 		return config
 	}
 
-	var err error
-	config.extensions, err = loadCommandExtensionsFromCluster(kymaConfig.Ctx, kymaConfig.KubeClientConfig)
+	localExtensions, err := loadCommandExtensionsFromLocal()
+	if err != nil {
+		config.extensionsErrors = append(config.extensionsErrors, err)
+	}
+
+	clusterExtensions, err := config.loadClusterExtensions(kymaConfig.Ctx, kymaConfig.KubeClientConfig)
+	if err != nil {
+		config.extensionsErrors = append(config.extensionsErrors, err)
+	}
+
+	// local extensions are first to take precedence over the cluster ones
+	config.extensions, err = mergeExtensions(localExtensions, clusterExtensions)
 	if err != nil {
 		config.extensionsErrors = append(config.extensionsErrors, err)
 	}
@@ -47,6 +85,14 @@ func AddCmdPersistentFlags(cmd *cobra.Command) {
 	// these flags are not operational. it's only to print the help description, and the help cobra with validation
 	_ = cmd.PersistentFlags().Bool("skip-extensions", false, "Skips fetching extensions from the target Kyma environment")
 	_ = cmd.PersistentFlags().Bool("show-extensions-error", false, "Prints a possible error when fetching extensions fails")
+	_ = cmd.PersistentFlags().Bool("refresh-extensions", false, "Fetches extensions from the target Kyma environment ignoring the cached ones")
+}
+
+// Wait waits until the background revalidation of cached extensions is done
+func (b *Builder) Wait() {
+	if b.revalidation != nil {
+		b.revalidation.Wait()
+	}
 }
 
 func (b *Builder) DisplayWarnings() {
@@ -55,6 +101,17 @@ func (b *Builder) DisplayWarnings() {
 		return
 	}
 
+	// refused extensions are always listed because the user may need to trust them explicitly
+	refused := []string{}
+	for _, rejected := range b.rejectedExtensions {
+		if rejected.Refused {
+			refused = append(refused, fmt.Sprintf("  - %s: %s", rejected.Source(), rejected.Reason))
+		}
+	}
+	if len(refused) > 0 {
+		b.printer.Errfln("Extensions Warning:\nrefused untrusted extensions:\n%s\n", strings.Join(refused, "\n"))
+	}
+
 	if len(b.extensionsErrors) > 0 && getBoolFlagValue("--show-extensions-error") {
 		// print error as warning if expected and continue
 		b.printer.Errfln("Extensions Warning:\n%s\n", errors.NewList(b.extensionsErrors...).Error())
@@ -63,36 +120,75 @@ func (b *Builder) DisplayWarnings() {
 	}
 }
 
-// Build - compose extensions based on extensions configmaps from a cluster
+// Build - compose extensions based on extensions configmaps from a cluster and local files
 // any errors can be displayed by using the DisplayExtensionsErrors func
 func (b *Builder) Build(parentCmd *cobra.Command, availableActions types.ActionsMap) {
 	for _, cmExt := range b.extensions {
-		// validate
-		err := cmExt.Extension.Validate()
+		err := buildExtension(parentCmd, cmExt, availableActions)
 		if err != nil {
-			b.extensionsErrors = append(b.extensionsErrors,
-				errors.Wrapf(err, "failed to validate extension from configmap '%s/%s'", cmExt.ConfigMapNamespace, cmExt.ConfigMapName))
-			continue
+			b.extensionsErrors = append(b.extensionsErrors, err)
 		}
 
-		// build final commands tree
-		command, err := buildCommand(cmExt.Extension, availableActions)
-		if err != nil {
-			b.extensionsErrors = append(b.extensionsErrors,
-				errors.Wrapf(err, "failed to build extension from configmap '%s/%s'", cmExt.ConfigMapNamespace, cmExt.ConfigMapName))
-			continue
+		if unsupported := UnsupportedActions(cmExt.Extension, availableActions); err == nil && len(unsupported) != 0 {
+			// commands are built but report the problem before the user runs them
+			b.extensionsErrors = append(b.extensionsErrors, errors.Newf(
+				"extension from %s uses actions not supported by this CLI version: %s, upgrade Kyma CLI to the latest version",
+				cmExt.Source(), strings.Join(unsupported, ", ")))
 		}
 
-		// check command duplicates
-		if hasCommand(parentCmd, command) {
-			b.extensionsErrors = append(b.extensionsErrors,
-				errors.Newf("failed to add extension from configmap '%s/%s': base command with name '%s' already exists",
-					cmExt.ConfigMapNamespace, cmExt.ConfigMapName, command.Name()))
-			continue
-		}
+		b.extensionsStatuses = append(b.extensionsStatuses, ExtensionStatus{
+			ConfigmapCommandExtension: cmExt,
+			Error:                     err,
+		})
+	}
+}
+
+func buildExtension(parentCmd *cobra.Command, cmExt types.ConfigmapCommandExtension, availableActions types.ActionsMap) error {
+	// check if the extension can be run by this CLI build
+	err := CheckCompatibility(cmExt.Extension)
+	if err != nil {
+		return errors.Wrapf(err, "incompatible extension from %s", cmExt.Source())
+	}
+
+	// validate
+	err = cmExt.Extension.Validate()
+	if err != nil {
+		return errors.Wrapf(err, "failed to validate extension from %s", cmExt.Source())
+	}
+
+	// build final commands tree
+	command, err := buildCommand(cmExt.Extension, availableActions)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build extension from %s", cmExt.Source())
+	}
+
+	source := cmExt.Source()
+	if cmExt.Signed {
+		source += " (signature verified)"
+	}
+	appendSourceToHelp(command, source)
 
-		// append extension command
-		parentCmd.AddCommand(command)
+	// check command duplicates
+	if hasCommand(parentCmd, command) {
+		return errors.Newf("failed to add extension from %s: base command with name '%s' already exists",
+			cmExt.Source(), command.Name())
+	}
+
+	// append extension command
+	parentCmd.AddCommand(command)
+	return nil
+}
+
+// appendSourceToHelp adds information about the place the extension comes from to the help of all its commands
+func appendSourceToHelp(cmd *cobra.Command, source string) {
+	description := cmd.Long
+	if description == "" {
+		description = cmd.Short
+	}
+
+	cmd.Long = strings.TrimSpace(fmt.Sprintf("%s\n\nExtension source: %s", description, source))
+	for _, subCmd := range cmd.Commands() {
+		appendSourceToHelp(subCmd, source)
 	}
 }
 
@@ -107,47 +203,105 @@ func hasCommand(base *cobra.Command, cmd *cobra.Command) bool {
 	return false
 }
 
-func loadCommandExtensionsFromCluster(ctx context.Context, clientConfig cmdcommon.KubeClientConfig) ([]types.ConfigmapCommandExtension, error) {
-	var cms, cmsError = listCommandExtenionConfigMaps(ctx, clientConfig)
+func (b *Builder) loadClusterExtensions(ctx context.Context, clientConfig cmdcommon.KubeClientConfig) ([]types.ConfigmapCommandExtension, error) {
+	client, clientErr := clientConfig.GetKubeClient()
+	if clientErr != nil {
+		return nil, clientErr
+	}
+
+	return b.loadCommandExtensionsFromClusterWithCache(ctx, client)
+}
+
+func loadCommandExtensionsFromCluster(ctx context.Context, client kube.Client) ([]types.ConfigmapCommandExtension, []RejectedExtension, error) {
+	var cms, cmsError = listCommandExtenionConfigMaps(ctx, client)
 	if cmsError != nil {
-		return nil, cmsError
+		return nil, nil, cmsError
+	}
+
+	return parseCommandExtensionConfigMaps(cms)
+}
+
+// parseCommandExtensionConfigMaps returns extensions from trusted configmaps and all configmaps that can't be loaded
+// returned error contains only problems with invalid configmaps, refused ones are reported separately
+func parseCommandExtensionConfigMaps(cms *v1.ConfigMapList) ([]types.ConfigmapCommandExtension, []RejectedExtension, error) {
+	policy, err := loadTrustPolicy()
+	if err != nil {
+		// don't load any extension from the cluster if the policy can't be checked
+		return nil, nil, errors.Wrap(err, "failed to load extensions trust policy")
 	}
 
 	extensions := []types.ConfigmapCommandExtension{}
+	rejected := []RejectedExtension{}
 	var parseErrors []error
 	for _, cm := range cms.Items {
-		commandExtension, err := parseRequiredField[types.Extension](cm.Data, types.ExtensionCMDataKey)
+		signed, err := policy.verify(&cm)
 		if err != nil {
-			parseErrors = append(parseErrors,
-				errors.Wrapf(err, "failed to parse configmap '%s/%s'", cm.GetNamespace(), cm.GetName()))
+			rejected = append(rejected, newRejectedExtension(&cm, true, err))
 			continue
 		}
 
-		if slices.ContainsFunc(extensions, func(e types.ConfigmapCommandExtension) bool {
-			return e.Extension.Metadata.Name == commandExtension.Metadata.Name
-		}) {
+		commandExtension, err := parseRequiredField[types.Extension](cm.Data, types.ExtensionCMDataKey)
+		if err != nil {
+			rejected = append(rejected, newRejectedExtension(&cm, false, err))
 			parseErrors = append(parseErrors,
-				errors.Newf("failed to validate configmap '%s/%s': extension with name '%s' already exists",
-					cm.GetNamespace(), cm.GetName(), commandExtension.Metadata.Name))
+				errors.Wrapf(err, "failed to parse configmap '%s/%s'", cm.GetNamespace(), cm.GetName()))
 			continue
 		}
 
-		extensions = append(extensions, types.ConfigmapCommandExtension{
+		extensions, err = appendUniqueExtension(extensions, types.ConfigmapCommandExtension{
 			ConfigMapName:      cm.GetName(),
 			ConfigMapNamespace: cm.GetNamespace(),
+			Signed:             signed,
 			Extension:          *commandExtension,
 		})
+		if err != nil {
+			rejected = append(rejected, newRejectedExtension(&cm, false, err))
+			parseErrors = append(parseErrors, err)
+		}
 	}
 
-	return extensions, errors.NewList(parseErrors...)
+	return extensions, rejected, errors.NewList(parseErrors...)
 }
 
-func listCommandExtenionConfigMaps(ctx context.Context, clientConfig cmdcommon.KubeClientConfig) (*v1.ConfigMapList, error) {
-	client, clientErr := clientConfig.GetKubeClient()
-	if clientErr != nil {
-		return nil, clientErr
+func newRejectedExtension(cm *v1.ConfigMap, refused bool, reason error) RejectedExtension {
+	return RejectedExtension{
+		ConfigMapName:      cm.GetName(),
+		ConfigMapNamespace: cm.GetNamespace(),
+		Refused:            refused,
+		Reason:             reason.Error(),
+	}
+}
+
+// mergeExtensions joins given lists of extensions in order and skips extensions with already existing names
+func mergeExtensions(extensionLists ...[]types.ConfigmapCommandExtension) ([]types.ConfigmapCommandExtension, error) {
+	extensions := []types.ConfigmapCommandExtension{}
+	var mergeErrors []error
+	for _, list := range extensionLists {
+		for _, extension := range list {
+			var err error
+			extensions, err = appendUniqueExtension(extensions, extension)
+			if err != nil {
+				mergeErrors = append(mergeErrors, err)
+			}
+		}
+	}
+
+	return extensions, errors.NewList(mergeErrors...)
+}
+
+// appendUniqueExtension appends extension to the list only if there is no other extension with the same name
+func appendUniqueExtension(extensions []types.ConfigmapCommandExtension, extension types.ConfigmapCommandExtension) ([]types.ConfigmapCommandExtension, error) {
+	if slices.ContainsFunc(extensions, func(e types.ConfigmapCommandExtension) bool {
+		return e.Extension.Metadata.Name == extension.Extension.Metadata.Name
+	}) {
+		return extensions, errors.Newf("failed to validate %s: extension with name '%s' already exists",
+			extension.Source(), extension.Extension.Metadata.Name)
 	}
 
+	return append(extensions, extension), nil
+}
+
+func listCommandExtenionConfigMaps(ctx context.Context, client kube.Client) (*v1.ConfigMapList, error) {
 	labelSelector := fmt.Sprintf("%s==%s", types.ExtensionCMLabelKey, types.ExtensionCMLabelValue)
 	cms, err := client.Static().CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
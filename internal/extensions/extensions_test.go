@@ -139,6 +139,22 @@ func Test_DisplayWarnings(t *testing.T) {
 
 		require.Equal(t, "Extensions Warning:\ntest error\n\n", buffer.String())
 	})
+
+	t.Run("always display refused extensions", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		b := Builder{
+			printer: out.NewToWriter(buffer),
+			rejectedExtensions: []RejectedExtension{
+				{ConfigMapName: "untrusted", ConfigMapNamespace: "default", Refused: true, Reason: "namespace 'default' is not allowed"},
+				{ConfigMapName: "invalid", ConfigMapNamespace: "kyma-system", Refused: false, Reason: "parse error"},
+			},
+		}
+
+		b.DisplayWarnings()
+
+		require.Equal(t, "Extensions Warning:\nrefused untrusted extensions:\n"+
+			"  - configmap 'default/untrusted': namespace 'default' is not allowed\n\n", buffer.String())
+	})
 }
 
 func Test_NewBuilder(t *testing.T) {
@@ -211,6 +227,43 @@ func Test_NewBuilder(t *testing.T) {
 		require.Equal(t, expectedExtensions, b.extensions)
 	})
 
+	t.Run("merge local and cluster extensions", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		localExtension := fixExtensionFile(t, t.TempDir(), "resource.yaml", testExtensionString)
+		t.Setenv(ExtensionsPathEnv, localExtension)
+
+		b := NewBuilder(&cmdcommon.KymaConfig{
+			Ctx: context.Background(),
+			KubeClientConfig: &fakeKubeClientConfig{
+				kubeClient: &kubefake.KubeClient{
+					TestKubernetesInterface: k8sfake.NewClientset(
+						fixTestExtensionConfigMap("cm1", testExtensionString),
+						fixTestExtensionConfigMap("cm2", "metadata:\n  name: other\n"),
+					),
+				},
+			},
+		})
+
+		expectedExtensions := []types.ConfigmapCommandExtension{
+			{
+				FilePath:  localExtension,
+				Extension: testExtension,
+			},
+			{
+				ConfigMapName:      "cm2",
+				ConfigMapNamespace: "kyma-system",
+				Extension: types.Extension{
+					Metadata: types.Metadata{
+						Name: "other",
+					},
+				},
+			},
+		}
+
+		require.Equal(t, []error{errors.NewList(errors.New("failed to validate configmap 'kyma-system/cm1': extension with name 'resource' already exists"))}, b.extensionsErrors)
+		require.Equal(t, expectedExtensions, b.extensions)
+	})
+
 	t.Run("handle missing required cm value error", func(t *testing.T) {
 		b := NewBuilder(&cmdcommon.KymaConfig{
 			Ctx: context.Background(),
@@ -310,6 +363,23 @@ func Test_Build(t *testing.T) {
 		require.Equal(t, "resource", cmd.Commands()[1].Name())
 	})
 
+	t.Run("handle local extension error", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		b := Builder{
+			extensions: []types.ConfigmapCommandExtension{
+				{
+					FilePath:  "/tmp/extension.yaml",
+					Extension: types.Extension{},
+				},
+			},
+		}
+
+		b.Build(cmd, testActionsMap)
+
+		require.Equal(t, []error{errors.New("failed to validate extension from file '/tmp/extension.yaml':\n  wrong .metadata: empty name")}, b.extensionsErrors)
+		require.Empty(t, cmd.Commands())
+	})
+
 	t.Run("handle build command error", func(t *testing.T) {
 		cmd := &cobra.Command{}
 		b := Builder{
@@ -341,6 +411,55 @@ func Test_Build(t *testing.T) {
 			"    flag 'test-flag' error: strconv.ParseInt: parsing \"WRONG VALUE\": invalid syntax")}, b.extensionsErrors)
 		require.Empty(t, cmd.Commands())
 	})
+
+	t.Run("handle incompatible extension", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		b := Builder{
+			extensions: []types.ConfigmapCommandExtension{
+				{
+					ConfigMapName:      "cm1",
+					ConfigMapNamespace: "ns",
+					Extension: types.Extension{
+						APIVersion: "v2",
+						Metadata: types.Metadata{
+							Name: "create",
+						},
+					},
+				},
+			},
+		}
+
+		b.Build(cmd, testActionsMap)
+
+		require.Equal(t, []error{errors.New("incompatible extension from configmap 'ns/cm1': " +
+			"apiVersion 'v2' is not supported (supported versions: v1), upgrade Kyma CLI to the latest version")}, b.extensionsErrors)
+		require.Empty(t, cmd.Commands())
+	})
+
+	t.Run("report unsupported actions", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		b := Builder{
+			extensions: []types.ConfigmapCommandExtension{
+				{
+					ConfigMapName:      "cm1",
+					ConfigMapNamespace: "ns",
+					Extension: types.Extension{
+						Metadata: types.Metadata{
+							Name: "create",
+						},
+						Action: "new-action",
+					},
+				},
+			},
+		}
+
+		b.Build(cmd, testActionsMap)
+
+		require.Equal(t, []error{errors.New("extension from configmap 'ns/cm1' uses actions not supported by this CLI version: " +
+			"new-action, upgrade Kyma CLI to the latest version")}, b.extensionsErrors)
+		require.Len(t, cmd.Commands(), 1)
+		require.Nil(t, b.Statuses()[0].Error)
+	})
 }
 
 func fixTestExtensionConfigMap(name, data string) *corev1.ConfigMap {
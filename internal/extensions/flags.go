@@ -2,10 +2,15 @@ package extensions
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
 	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
@@ -52,3 +57,68 @@ func buildFlag(commandFlag types.Flag, overwrites map[string]interface{}) flag {
 		warning: warning,
 	}
 }
+
+// buildFlagRule returns rule checking if the flag value meets restrictions from the extension definition
+func buildFlagRule(commandFlag types.Flag) flags.Rule {
+	return func(flagSet *pflag.FlagSet) error {
+		pflag := flagSet.Lookup(commandFlag.Name)
+		if pflag == nil || !pflag.Changed {
+			// validate only values passed by the user, default value is the extension owner's responsibility
+			return nil
+		}
+
+		value, ok := pflag.Value.(parameters.Value)
+		if !ok || value.GetValue() == nil {
+			return nil
+		}
+
+		elems, ok := value.GetValue().([]interface{})
+		if !ok {
+			elems = []interface{}{value.GetValue()}
+		}
+
+		var errs []error
+		for _, elem := range elems {
+			errs = append(errs, validateFlagElem(commandFlag, elem))
+		}
+
+		return errors.JoinWithSeparator(", ", errs...)
+	}
+}
+
+func validateFlagElem(commandFlag types.Flag, elem interface{}) error {
+	if len(commandFlag.AllowedValues) != 0 && !slices.Contains(commandFlag.AllowedValues, fmt.Sprintf("%v", elem)) {
+		return errors.Newf("flag '%s' value '%v' is not one of allowed values [%s]",
+			commandFlag.Name, elem, strings.Join(commandFlag.AllowedValues, ", "))
+	}
+
+	if stringElem, ok := elem.(string); ok && commandFlag.Pattern != "" {
+		matched, err := regexp.MatchString(commandFlag.Pattern, stringElem)
+		if err != nil || !matched {
+			return errors.Newf("flag '%s' value '%s' does not match the '%s' pattern",
+				commandFlag.Name, stringElem, commandFlag.Pattern)
+		}
+	}
+
+	if intElem, ok := elem.(int64); ok {
+		if commandFlag.Min != nil && intElem < *commandFlag.Min {
+			return errors.Newf("flag '%s' value %d is lower than the minimum %d", commandFlag.Name, intElem, *commandFlag.Min)
+		}
+
+		if commandFlag.Max != nil && intElem > *commandFlag.Max {
+			return errors.Newf("flag '%s' value %d is greater than the maximum %d", commandFlag.Name, intElem, *commandFlag.Max)
+		}
+	}
+
+	return nil
+}
+
+// registerFlagCompletion adds allowed values of the enum flag to the shell completion
+func registerFlagCompletion(cmd *cobra.Command, commandFlag types.Flag) error {
+	if len(commandFlag.AllowedValues) == 0 {
+		return nil
+	}
+
+	return cmd.RegisterFlagCompletionFunc(commandFlag.Name,
+		cobra.FixedCompletions(commandFlag.AllowedValues, cobra.ShellCompDirectiveNoFileComp))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
 )
@@ -158,6 +159,26 @@ func Test_buildFlag(t *testing.T) {
 		}, fixEmptyOverwrites())
 		require.ErrorContains(t, givenFlag.warning, "parsing \"WRONG VALUE\": invalid syntax")
 	})
+
+	t.Run("build array flag", func(t *testing.T) {
+		overwrites := fixEmptyOverwrites()
+
+		givenFlag := buildFlag(types.Flag{
+			Type:         parameters.IntArrayCustomType,
+			Name:         "ports",
+			DefaultValue: toPtr("80,443"),
+		}, overwrites)
+
+		require.Nil(t, givenFlag.warning)
+		require.Equal(t, "[80,443]", givenFlag.pflag.DefValue)
+		require.Equal(t, "intArray", givenFlag.pflag.Value.Type())
+		require.Equal(t, []interface{}{int64(80), int64(443)}, overwrites["flags"].(map[string]interface{})["ports"].(map[string]interface{})["value"])
+
+		// first value replaces the default one
+		require.NoError(t, givenFlag.pflag.Value.Set("8080"))
+		require.NoError(t, givenFlag.pflag.Value.Set("9090"))
+		require.Equal(t, []interface{}{int64(8080), int64(9090)}, givenFlag.value.GetValue())
+	})
 }
 
 func fixEmptyOverwrites() map[string]interface{} {
@@ -165,3 +186,119 @@ func fixEmptyOverwrites() map[string]interface{} {
 		"flags": map[string]interface{}{},
 	}
 }
+
+func Test_buildFlagRule(t *testing.T) {
+	t.Run("accept valid values", func(t *testing.T) {
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		runtime := types.Flag{
+			Type:          parameters.EnumCustomType,
+			Name:          "runtime",
+			AllowedValues: []string{"nodejs22", "python312"},
+		}
+		replicas := types.Flag{
+			Type: parameters.IntArrayCustomType,
+			Name: "replicas",
+			Min:  toPtr[int64](1),
+			Max:  toPtr[int64](3),
+		}
+		flagSet.AddFlag(buildFlag(runtime, fixEmptyOverwrites()).pflag)
+		flagSet.AddFlag(buildFlag(replicas, fixEmptyOverwrites()).pflag)
+
+		require.NoError(t, flagSet.Parse([]string{"--runtime", "python312", "--replicas", "1", "--replicas", "3"}))
+		require.NoError(t, buildFlagRule(runtime)(flagSet))
+		require.NoError(t, buildFlagRule(replicas)(flagSet))
+	})
+
+	t.Run("skip unset flag", func(t *testing.T) {
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		runtime := types.Flag{
+			Type:          parameters.EnumCustomType,
+			Name:          "runtime",
+			AllowedValues: []string{"nodejs22"},
+		}
+		flagSet.AddFlag(buildFlag(runtime, fixEmptyOverwrites()).pflag)
+
+		require.NoError(t, buildFlagRule(runtime)(flagSet))
+	})
+
+	t.Run("skip default value not passed by the user", func(t *testing.T) {
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		runtime := types.Flag{
+			Type:          parameters.EnumCustomType,
+			Name:          "runtime",
+			DefaultValue:  toPtr("java"),
+			AllowedValues: []string{"nodejs22"},
+		}
+		flagSet.AddFlag(buildFlag(runtime, fixEmptyOverwrites()).pflag)
+
+		require.NoError(t, flagSet.Parse([]string{}))
+		require.NoError(t, buildFlagRule(runtime)(flagSet))
+
+		require.NoError(t, flagSet.Parse([]string{"--runtime", "java"}))
+		require.EqualError(t, buildFlagRule(runtime)(flagSet),
+			"flag 'runtime' value 'java' is not one of allowed values [nodejs22]")
+	})
+
+	t.Run("reject value out of allowed values", func(t *testing.T) {
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		runtime := types.Flag{
+			Type:          parameters.EnumCustomType,
+			Name:          "runtime",
+			AllowedValues: []string{"nodejs22", "python312"},
+		}
+		flagSet.AddFlag(buildFlag(runtime, fixEmptyOverwrites()).pflag)
+
+		require.NoError(t, flagSet.Parse([]string{"--runtime", "java"}))
+		require.EqualError(t, buildFlagRule(runtime)(flagSet),
+			"flag 'runtime' value 'java' is not one of allowed values [nodejs22, python312]")
+	})
+
+	t.Run("reject value not matching pattern", func(t *testing.T) {
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		names := types.Flag{
+			Type:    parameters.StringArrayCustomType,
+			Name:    "name",
+			Pattern: "^[a-z]+$",
+		}
+		flagSet.AddFlag(buildFlag(names, fixEmptyOverwrites()).pflag)
+
+		require.NoError(t, flagSet.Parse([]string{"--name", "ok", "--name", "Not-Ok"}))
+		require.EqualError(t, buildFlagRule(names)(flagSet),
+			"flag 'name' value 'Not-Ok' does not match the '^[a-z]+$' pattern")
+	})
+
+	t.Run("reject int values out of range", func(t *testing.T) {
+		flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		replicas := types.Flag{
+			Type: parameters.IntArrayCustomType,
+			Name: "replicas",
+			Min:  toPtr[int64](1),
+			Max:  toPtr[int64](3),
+		}
+		flagSet.AddFlag(buildFlag(replicas, fixEmptyOverwrites()).pflag)
+
+		require.NoError(t, flagSet.Parse([]string{"--replicas", "0", "--replicas", "4"}))
+		require.EqualError(t, buildFlagRule(replicas)(flagSet),
+			"flag 'replicas' value 0 is lower than the minimum 1, flag 'replicas' value 4 is greater than the maximum 3")
+	})
+}
+
+func Test_registerFlagCompletion(t *testing.T) {
+	t.Run("complete enum values", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		runtime := types.Flag{
+			Type:          parameters.EnumCustomType,
+			Name:          "runtime",
+			AllowedValues: []string{"nodejs22", "python312"},
+		}
+		cmd.Flags().AddFlag(buildFlag(runtime, fixEmptyOverwrites()).pflag)
+
+		require.NoError(t, registerFlagCompletion(cmd, runtime))
+
+		completionFunc, ok := cmd.GetFlagCompletionFunc("runtime")
+		require.True(t, ok)
+		values, directive := completionFunc(cmd, nil, "")
+		require.Equal(t, []string{"nodejs22", "python312"}, values)
+		require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+}
@@ -0,0 +1,160 @@
+package extensions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/cobra"
+)
+
+// Statuses returns all discovered extensions with the result of building them
+// it returns full list only after running the Build func
+func (b *Builder) Statuses() []ExtensionStatus {
+	return b.extensionsStatuses
+}
+
+// Rejected returns configmaps from the cluster that are refused by the trust policy or invalid
+func (b *Builder) Rejected() []RejectedExtension {
+	return b.rejectedExtensions
+}
+
+// LoadFromFile reads and parses the extension from the local file
+func LoadFromFile(path string) (*types.ConfigmapCommandExtension, error) {
+	extensions, err := loadCommandExtensionsFromFiles([]string{path})
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensions[0], nil
+}
+
+// BuildCommand builds commands tree from the extension without adding it to any parent command
+func BuildCommand(extension types.Extension, availableActions types.ActionsMap) (*cobra.Command, error) {
+	return buildCommand(extension, availableActions)
+}
+
+// Validate validates the extension definition and configures actions of all commands offline using default flags values
+func Validate(extension types.Extension, availableActions types.ActionsMap) error {
+	err := CheckCompatibility(extension)
+	if err != nil {
+		return errors.Wrap(err, "incompatible extension")
+	}
+
+	err = extension.Validate()
+	if err != nil {
+		return errors.Wrap(err, "failed to validate extension")
+	}
+
+	return validateActions(extension, availableActions, commandName(extension))
+}
+
+func validateActions(extension types.Extension, availableActions types.ActionsMap, cmdPath string) error {
+	var errs []error
+	if extension.Action != "" {
+		err := validateAction(extension, extension.Action, extension.Config, availableActions, nil)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "command '%s'", cmdPath))
+		}
+	}
+
+	// steps can use outputs of all previous steps
+	stepIDs := []string{}
+	for i, step := range extension.Steps {
+		err := validateAction(extension, step.Action, step.Config, availableActions, stepIDs)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "command '%s' step %s", cmdPath, stepName(i, step)))
+		}
+
+		if step.ID != "" {
+			stepIDs = append(stepIDs, step.ID)
+		}
+	}
+
+	for i, step := range extension.CleanupSteps {
+		err := validateAction(extension, step.Action, step.Config, availableActions, stepIDs)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "command '%s' cleanup step %s", cmdPath, stepName(i, step)))
+		}
+	}
+
+	for _, subExtension := range extension.SubCommands {
+		errs = append(errs, validateActions(subExtension, availableActions,
+			fmt.Sprintf("%s %s", cmdPath, commandName(subExtension))))
+	}
+
+	return errors.NewList(errs...)
+}
+
+// validateAction configures the action offline with empty outputs of the given previous steps
+func validateAction(extension types.Extension, actionID string, config types.ActionConfig, availableActions types.ActionsMap, stepIDs []string) error {
+	action, ok := availableActions[actionID]
+	if !ok {
+		return errors.Newf("unsupported action '%s'", actionID)
+	}
+
+	cmdInputs, err := buildInputs(&cobra.Command{}, extension)
+	if err != nil {
+		return err
+	}
+
+	// outputs are known only after running steps, so let templates access them without failing
+	stepsOverwrites := map[string]interface{}{}
+	for _, stepID := range stepIDs {
+		stepsOverwrites[stepID] = map[string]interface{}{
+			"outputs": map[string]interface{}{},
+		}
+	}
+	cmdInputs.overwrites["steps"] = stepsOverwrites
+
+	clierr := cmdInputs.configure(action, config)
+	if clierr != nil && usesNestedStepOutputs(clierr, stepIDs) {
+		// nested fields of outputs can't be templated offline
+		return nil
+	}
+	if clierr != nil {
+		// keep the whole cli error (with details and hints) as nested error
+		return errors.NewList(errors.New(strings.TrimSpace(clierr.String())))
+	}
+
+	return nil
+}
+
+// usesNestedStepOutputs returns true if the config templating failed on nested fields of previous steps outputs
+func usesNestedStepOutputs(clierr clierror.Error, stepIDs []string) bool {
+	for _, stepID := range stepIDs {
+		if strings.Contains(clierr.String(), fmt.Sprintf("at <.steps.%s.outputs.", stepID)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListActions returns sorted IDs of all actions used by the extension and its sub-commands
+func ListActions(extension types.Extension) []string {
+	actions := []string{}
+	if extension.Action != "" {
+		actions = append(actions, extension.Action)
+	}
+
+	for _, step := range slices.Concat(extension.Steps, extension.CleanupSteps) {
+		actions = append(actions, step.Action)
+	}
+
+	for _, subExtension := range extension.SubCommands {
+		actions = append(actions, ListActions(subExtension)...)
+	}
+
+	slices.Sort(actions)
+	return slices.Compact(actions)
+}
+
+// returns the command name the same way cobra does it
+func commandName(extension types.Extension) string {
+	name, _, _ := strings.Cut(extension.Metadata.Name, " ")
+	return name
+}
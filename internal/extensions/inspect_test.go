@@ -0,0 +1,199 @@
+package extensions
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Statuses(t *testing.T) {
+	t.Run("return built and failed extensions", func(t *testing.T) {
+		failedExtension := types.ConfigmapCommandExtension{
+			ConfigMapName:      "cm2",
+			ConfigMapNamespace: "ns",
+			Extension: types.Extension{
+				Metadata: types.Metadata{
+					// missing name
+				},
+			},
+		}
+		b := Builder{
+			extensions: []types.ConfigmapCommandExtension{
+				{
+					ConfigMapName:      "cm1",
+					ConfigMapNamespace: "ns",
+					Extension:          testExtension,
+				},
+				failedExtension,
+			},
+		}
+
+		b.Build(&cobra.Command{}, testActionsMap)
+
+		statuses := b.Statuses()
+		require.Len(t, statuses, 2)
+		require.Equal(t, "resource", statuses[0].Extension.Metadata.Name)
+		require.NoError(t, statuses[0].Error)
+		require.Equal(t, failedExtension, statuses[1].ConfigmapCommandExtension)
+		require.Error(t, statuses[1].Error)
+	})
+}
+
+func Test_LoadFromFile(t *testing.T) {
+	t.Run("load extension", func(t *testing.T) {
+		path := fixExtensionFile(t, t.TempDir(), "resource.yaml", testExtensionString)
+
+		extension, err := LoadFromFile(path)
+		require.NoError(t, err)
+		require.Equal(t, &types.ConfigmapCommandExtension{
+			FilePath:  path,
+			Extension: testExtension,
+		}, extension)
+	})
+
+	t.Run("handle missing file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "missing.yaml")
+
+		extension, err := LoadFromFile(path)
+		require.Equal(t, errors.NewList(
+			errors.Newf("failed to read file '%s': open %s: no such file or directory", path, path),
+		), err)
+		require.Nil(t, extension)
+	})
+}
+
+func Test_Validate(t *testing.T) {
+	t.Run("validate extension", func(t *testing.T) {
+		err := Validate(testExtension, testActionsMap)
+		require.NoError(t, err)
+	})
+
+	t.Run("handle wrong extension definition", func(t *testing.T) {
+		err := Validate(types.Extension{}, testActionsMap)
+		require.Equal(t, errors.New("failed to validate extension:\n  wrong .metadata: empty name"), err)
+	})
+
+	t.Run("handle unsupported and misconfigured actions", func(t *testing.T) {
+		extension := types.Extension{
+			Metadata: types.Metadata{
+				Name: "resource [flags]",
+			},
+			SubCommands: []types.Extension{
+				{
+					Metadata: types.Metadata{Name: "get"},
+					Action:   "unknown",
+				},
+				{
+					Metadata: types.Metadata{Name: "create"},
+					Action:   "action-1",
+				},
+			},
+		}
+		actions := types.ActionsMap{
+			"action-1": &mockAction{
+				configureError: clierror.New("wrong config"),
+			},
+		}
+
+		err := Validate(extension, actions)
+		require.EqualError(t, err, "command 'resource get': unsupported action 'unknown'\n"+
+			"command 'resource create':\n  Error:\n    wrong config")
+	})
+
+	t.Run("validate steps using outputs of previous steps", func(t *testing.T) {
+		extension := types.Extension{
+			Metadata: types.Metadata{Name: "push"},
+			Steps: []types.Step{
+				{
+					ID:     "create",
+					Action: "template",
+					Config: map[string]interface{}{"name": "app"},
+				},
+				{
+					ID:     "deploy",
+					Action: "template",
+					Config: map[string]interface{}{
+						"name":      "${{ .steps.create.outputs.name }}",
+						"namespace": "${{ .steps.create.outputs.resource.metadata.namespace }}",
+					},
+				},
+			},
+			CleanupSteps: []types.Step{
+				{
+					Action: "template",
+					Config: map[string]interface{}{"name": "${{ .steps.deploy.outputs.name }}"},
+				},
+			},
+		}
+
+		err := Validate(extension, types.ActionsMap{
+			"template": &templateAction{},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("handle steps using outputs of next steps", func(t *testing.T) {
+		extension := types.Extension{
+			Metadata: types.Metadata{Name: "push"},
+			Steps: []types.Step{
+				{
+					ID:     "create",
+					Action: "template",
+					Config: map[string]interface{}{"name": "${{ .steps.deploy.outputs.name }}"},
+				},
+				{
+					ID:     "deploy",
+					Action: "template",
+				},
+			},
+		}
+
+		err := Validate(extension, types.ActionsMap{
+			"template": &templateAction{},
+		})
+		require.ErrorContains(t, err, "command 'push' step 'create'")
+	})
+
+	t.Run("handle unsupported steps actions", func(t *testing.T) {
+		err := Validate(fixStepsExtension(), types.ActionsMap{
+			"build": &mockAction{},
+		})
+		require.EqualError(t, err, "command 'push' step 'deploy': unsupported action 'deploy'\n"+
+			"command 'push' cleanup step 1 (cleanup): unsupported action 'cleanup'")
+	})
+}
+
+func Test_ListActions(t *testing.T) {
+	t.Run("list unique actions", func(t *testing.T) {
+		extension := fixTestExtension()
+		extension.SubCommands = append(extension.SubCommands, types.Extension{
+			Metadata: types.Metadata{Name: "other"},
+			Action:   "action1",
+		})
+
+		require.Equal(t, []string{"action1"}, ListActions(extension))
+	})
+
+	t.Run("list actions of steps", func(t *testing.T) {
+		require.Equal(t, []string{"build", "cleanup", "deploy"}, ListActions(fixStepsExtension()))
+	})
+
+	t.Run("list actions of group command", func(t *testing.T) {
+		require.Equal(t, []string{"action-1", "action-2"}, ListActions(testExtension))
+	})
+}
+
+// templateAction templates its config the same way real actions do
+type templateAction struct {
+	common.TemplateConfigurator[map[string]interface{}]
+}
+
+func (a *templateAction) Run(_ *cobra.Command, _ []string) clierror.Error {
+	return nil
+}
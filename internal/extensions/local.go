@@ -0,0 +1,112 @@
+package extensions
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// env containing list of additional files or directories with extensions separated by the os.PathListSeparator
+	ExtensionsPathEnv = "KYMA_EXTENSIONS_PATH"
+)
+
+var extensionFileExts = []string{".yaml", ".yml"}
+
+// loadCommandExtensionsFromLocal loads extensions from the default ~/.kyma/extensions directory
+// and from all files and directories listed in the KYMA_EXTENSIONS_PATH env
+func loadCommandExtensionsFromLocal() ([]types.ConfigmapCommandExtension, error) {
+	var errs []error
+	files := []string{}
+
+	homeDir, err := os.UserHomeDir()
+	if err == nil {
+		// default directory is optional and ignored if does not exist
+		dirFiles, err := listExtensionFiles(filepath.Join(homeDir, ".kyma", "extensions"))
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, errors.Wrap(err, "failed to list default local extensions directory"))
+		}
+		files = append(files, dirFiles...)
+	}
+
+	for _, path := range filepath.SplitList(os.Getenv(ExtensionsPathEnv)) {
+		if path == "" {
+			continue
+		}
+
+		pathFiles, err := listExtensionFiles(path)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to list extensions from the %s env", ExtensionsPathEnv))
+			continue
+		}
+		files = append(files, pathFiles...)
+	}
+
+	extensions, err := loadCommandExtensionsFromFiles(files)
+	errs = append(errs, err)
+
+	return extensions, errors.NewList(errs...)
+}
+
+// listExtensionFiles returns given path if it's a file or all yaml files from it if it's a directory
+func listExtensionFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(extensionFileExts, filepath.Ext(entry.Name())) {
+			continue
+		}
+
+		files = append(files, filepath.Join(path, entry.Name()))
+	}
+
+	return files, nil
+}
+
+func loadCommandExtensionsFromFiles(files []string) ([]types.ConfigmapCommandExtension, error) {
+	extensions := []types.ConfigmapCommandExtension{}
+	var parseErrors []error
+	for _, file := range files {
+		extension := types.ConfigmapCommandExtension{
+			FilePath: file,
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			parseErrors = append(parseErrors,
+				errors.Wrapf(err, "failed to read %s", extension.Source()))
+			continue
+		}
+
+		err = yaml.Unmarshal(data, &extension.Extension)
+		if err != nil {
+			parseErrors = append(parseErrors,
+				errors.Wrapf(err, "failed to parse %s", extension.Source()))
+			continue
+		}
+
+		extensions, err = appendUniqueExtension(extensions, extension)
+		if err != nil {
+			parseErrors = append(parseErrors, err)
+		}
+	}
+
+	return extensions, errors.NewList(parseErrors...)
+}
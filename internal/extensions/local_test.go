@@ -0,0 +1,106 @@
+package extensions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loadCommandExtensionsFromLocal(t *testing.T) {
+	t.Run("no local extensions", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(ExtensionsPathEnv, "")
+
+		extensions, err := loadCommandExtensionsFromLocal()
+		require.NoError(t, err)
+		require.Empty(t, extensions)
+	})
+
+	t.Run("load extensions from default directory", func(t *testing.T) {
+		homeDir := t.TempDir()
+		t.Setenv("HOME", homeDir)
+		t.Setenv(ExtensionsPathEnv, "")
+
+		extensionsDir := filepath.Join(homeDir, ".kyma", "extensions")
+		fixExtensionFile(t, extensionsDir, "resource.yaml", testExtensionString)
+		fixExtensionFile(t, extensionsDir, "README.md", "not an extension")
+
+		extensions, err := loadCommandExtensionsFromLocal()
+		require.NoError(t, err)
+		require.Equal(t, []types.ConfigmapCommandExtension{
+			{
+				FilePath:  filepath.Join(extensionsDir, "resource.yaml"),
+				Extension: testExtension,
+			},
+		}, extensions)
+	})
+
+	t.Run("load extensions from env paths", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		dir := t.TempDir()
+		dirExtension := fixExtensionFile(t, dir, "resource.yml", testExtensionString)
+		fileExtension := fixExtensionFile(t, t.TempDir(), "other.txt", "metadata:\n  name: other\n")
+		t.Setenv(ExtensionsPathEnv, strings.Join([]string{dir, fileExtension}, string(os.PathListSeparator)))
+
+		extensions, err := loadCommandExtensionsFromLocal()
+		require.NoError(t, err)
+		require.Equal(t, []types.ConfigmapCommandExtension{
+			{
+				FilePath:  dirExtension,
+				Extension: testExtension,
+			},
+			{
+				FilePath: fileExtension,
+				Extension: types.Extension{
+					Metadata: types.Metadata{
+						Name: "other",
+					},
+				},
+			},
+		}, extensions)
+	})
+
+	t.Run("handle missing env path", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		missingPath := filepath.Join(t.TempDir(), "missing")
+		t.Setenv(ExtensionsPathEnv, missingPath)
+
+		extensions, err := loadCommandExtensionsFromLocal()
+		require.Equal(t, errors.NewList(
+			errors.Newf("failed to list extensions from the KYMA_EXTENSIONS_PATH env: stat %s: no such file or directory", missingPath),
+		), err)
+		require.Empty(t, extensions)
+	})
+
+	t.Run("handle parse error and duplicates", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		dir := t.TempDir()
+		fixExtensionFile(t, dir, "a.yaml", testExtensionString)
+		duplicate := fixExtensionFile(t, dir, "b.yaml", testExtensionString)
+		broken := fixExtensionFile(t, dir, "c.yaml", "metadata: [")
+		t.Setenv(ExtensionsPathEnv, dir)
+
+		extensions, err := loadCommandExtensionsFromLocal()
+		require.Equal(t, errors.NewList(errors.NewList(
+			errors.Newf("failed to validate file '%s': extension with name 'resource' already exists", duplicate),
+			errors.Newf("failed to parse file '%s': yaml: line 1: did not find expected node content", broken),
+		)), err)
+		require.Len(t, extensions, 1)
+	})
+}
+
+func fixExtensionFile(t *testing.T, dir, name, data string) string {
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(data), os.ModePerm))
+
+	return path
+}
@@ -30,6 +30,8 @@ func NewTyped(paramType ConfigFieldType, resourcepath string) Value {
 		return &boolValue{path: resourcepath}
 	case MapCustomType:
 		return &mapValue{path: resourcepath, Map: cmdcommontypes.Map{Values: map[string]interface{}{}}}
+	case StringArrayCustomType, IntArrayCustomType, PathArrayCustomType:
+		return newArrayValue(paramType, resourcepath)
 	default:
 		return &stringValue{path: resourcepath}
 	}
@@ -48,6 +50,11 @@ func Set(obj map[string]interface{}, values []Value) clierror.Error {
 		}
 
 		fields := splitPath(extraValue.GetPath())
+		if _, ok := value.([]interface{}); ok {
+			// lists are replaced instead of being merged with existing ones element by element
+			unstructured.RemoveNestedField(obj, fields...)
+		}
+
 		subObj, err := buildExtraValuesObject(value, fields...)
 		if err != nil {
 			return clierror.Wrap(err, clierror.New(
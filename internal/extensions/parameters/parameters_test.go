@@ -152,6 +152,31 @@ func TestSet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "replace array value",
+			args: args{
+				obj: map[string]interface{}{
+					"flags": map[string]interface{}{
+						"names": map[string]interface{}{
+							"value": []interface{}{"default-1", "default-2"},
+						},
+					},
+				},
+				values: []Value{
+					&arrayValue{
+						path:   ".flags.names.value",
+						values: []interface{}{"name"},
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"flags": map[string]interface{}{
+					"names": map[string]interface{}{
+						"value": []interface{}{"name"},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
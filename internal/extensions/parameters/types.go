@@ -1,7 +1,10 @@
 package parameters
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	cmdcommontypes "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 )
@@ -14,6 +17,11 @@ const (
 	IntCustomType    ConfigFieldType = "int"
 	BoolCustomType   ConfigFieldType = "bool"
 	MapCustomType    ConfigFieldType = "map"
+	EnumCustomType   ConfigFieldType = "enum"
+
+	StringArrayCustomType ConfigFieldType = "stringArray"
+	IntArrayCustomType    ConfigFieldType = "intArray"
+	PathArrayCustomType   ConfigFieldType = "pathArray"
 )
 
 var (
@@ -23,6 +31,16 @@ var (
 		IntCustomType,
 		BoolCustomType,
 		MapCustomType,
+		EnumCustomType,
+		StringArrayCustomType,
+		IntArrayCustomType,
+		PathArrayCustomType,
+	}
+
+	ArrayTypes = []ConfigFieldType{
+		StringArrayCustomType,
+		IntArrayCustomType,
+		PathArrayCustomType,
 	}
 )
 
@@ -95,6 +113,107 @@ func (pv *pathValue) Set(path string) error {
 	return nil
 }
 
+// arrayValue collects values from the repeated flag or from many args
+type arrayValue struct {
+	path      string
+	fieldType ConfigFieldType
+	values    []interface{}
+	// true if the value was set by user and default value was dropped
+	changed bool
+	// parses single element passed by user
+	parse func(string) (interface{}, error)
+}
+
+func newArrayValue(fieldType ConfigFieldType, path string) *arrayValue {
+	value := &arrayValue{
+		path:      path,
+		fieldType: fieldType,
+		values:    []interface{}{},
+		parse:     func(s string) (interface{}, error) { return s, nil },
+	}
+
+	switch fieldType {
+	case IntArrayCustomType:
+		value.parse = func(s string) (interface{}, error) {
+			return strconv.ParseInt(s, 10, 64)
+		}
+	case PathArrayCustomType:
+		value.parse = func(s string) (interface{}, error) {
+			bytes, err := os.ReadFile(s)
+			return string(bytes), err
+		}
+	}
+
+	return value
+}
+
+func (v *arrayValue) String() string {
+	elems := make([]string, len(v.values))
+	for i := range v.values {
+		elems[i] = fmt.Sprintf("%v", v.values[i])
+	}
+
+	return "[" + strings.Join(elems, ",") + "]"
+}
+
+// SetValue sets default elements from the comma-separated string
+// the pathArray default value contains one element with the content, not a path to the file (the same as for the path type)
+func (v *arrayValue) SetValue(value *string) error {
+	if value == nil {
+		return nil
+	}
+
+	v.values = []interface{}{}
+	if *value == "" {
+		return nil
+	}
+
+	if v.fieldType == PathArrayCustomType {
+		v.values = append(v.values, *value)
+		return nil
+	}
+
+	for _, elem := range strings.Split(*value, ",") {
+		parsed, err := v.parse(strings.TrimSpace(elem))
+		if err != nil {
+			return err
+		}
+
+		v.values = append(v.values, parsed)
+	}
+
+	return nil
+}
+
+// Set implements the flag.Value interface and appends element to the list
+// first call replaces default elements
+func (v *arrayValue) Set(value string) error {
+	parsed, err := v.parse(value)
+	if err != nil {
+		return err
+	}
+
+	if !v.changed {
+		v.values = []interface{}{}
+		v.changed = true
+	}
+
+	v.values = append(v.values, parsed)
+	return nil
+}
+
+func (v *arrayValue) Type() string {
+	return string(v.fieldType)
+}
+
+func (v *arrayValue) GetValue() interface{} {
+	return v.values
+}
+
+func (v *arrayValue) GetPath() string {
+	return v.path
+}
+
 func getValueOrNil[T any](value *T) interface{} {
 	if value != nil {
 		return *value
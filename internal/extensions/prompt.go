@@ -0,0 +1,109 @@
+package extensions
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/pflag"
+	"golang.org/x/term"
+)
+
+type flagPrompter interface {
+	Prompt() (string, error)
+}
+
+// isInteractiveTerminal returns true if the user can answer prompts
+// it's a variable to allow overwriting it in tests
+var isInteractiveTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// newFlagPrompter returns prompt asking for the value of the given flag
+// it's a variable to allow overwriting it in tests
+var newFlagPrompter = func(commandFlag types.Flag, defaultValue string) flagPrompter {
+	message := promptMessage(commandFlag)
+	switch {
+	case commandFlag.Type == parameters.EnumCustomType && commandFlag.Required:
+		return prompt.NewOneOfStringList(message+":", "Type the value: ", commandFlag.AllowedValues)
+	case commandFlag.Type == parameters.EnumCustomType:
+		return prompt.NewOptionalOneOfStringList(message+":", "Type the value or leave empty to skip: ", commandFlag.AllowedValues)
+	case commandFlag.Type == parameters.BoolCustomType:
+		defaultBool, _ := strconv.ParseBool(defaultValue)
+		return &boolFlagPrompter{prompt.NewBool(message, defaultBool)}
+	case commandFlag.Required:
+		return prompt.NewString(message, defaultValue)
+	default:
+		// optional flags can be skipped
+		return prompt.NewOptionalString(message, defaultValue)
+	}
+}
+
+// promptMissingFlags asks for values of all prompt flags that are not set by the user
+// optional flags stay unset if the user skips them
+// in non-interactive terminal it does nothing and missing flags are reported by the flags validation
+func (i *inputs) promptMissingFlags(flagSet *pflag.FlagSet) clierror.Error {
+	if len(i.promptFlags) == 0 || !isInteractiveTerminal() {
+		return nil
+	}
+
+	for _, commandFlag := range i.promptFlags {
+		pflag := flagSet.Lookup(commandFlag.Name)
+		if pflag == nil || pflag.Changed {
+			continue
+		}
+
+		defaultValue := pflag.DefValue
+		if commandFlag.DefaultValue == nil {
+			// do not suggest zero value of the flag type
+			defaultValue = ""
+		}
+
+		value, err := newFlagPrompter(commandFlag, defaultValue).Prompt()
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to read value of the '%s' flag", commandFlag.Name)))
+		}
+
+		if value == "" && !commandFlag.Required {
+			// the user skipped the optional flag, leave it unset
+			continue
+		}
+
+		err = flagSet.Set(commandFlag.Name, value)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to set value of the '%s' flag", commandFlag.Name)))
+		}
+	}
+
+	return nil
+}
+
+func promptMessage(commandFlag types.Flag) string {
+	if commandFlag.PromptMessage != "" {
+		return commandFlag.PromptMessage
+	}
+
+	if commandFlag.Description != "" {
+		return commandFlag.Description
+	}
+
+	return fmt.Sprintf("Value of the '%s' flag", commandFlag.Name)
+}
+
+// boolFlagPrompter returns bool prompt answer as the flag value
+type boolFlagPrompter struct {
+	prompt *prompt.Bool
+}
+
+func (p *boolFlagPrompter) Prompt() (string, error) {
+	value, err := p.prompt.Prompt()
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatBool(value), nil
+}
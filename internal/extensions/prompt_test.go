@@ -0,0 +1,194 @@
+package extensions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_inputs_promptMissingFlags(t *testing.T) {
+	extension := types.Extension{
+		Metadata: types.Metadata{
+			Name: "create",
+		},
+		Flags: []types.Flag{
+			{
+				Type:     parameters.StringCustomType,
+				Name:     "name",
+				Required: true,
+				Prompt:   true,
+			},
+			{
+				Type:          parameters.EnumCustomType,
+				Name:          "runtime",
+				AllowedValues: []string{"nodejs22", "python312"},
+				DefaultValue:  toPtr("nodejs22"),
+				Prompt:        true,
+			},
+			{
+				Type: parameters.IntCustomType,
+				Name: "replicas",
+			},
+		},
+	}
+
+	t.Run("ask for missing flags", func(t *testing.T) {
+		fixInteractiveTerminal(t, true)
+		prompted := fixFlagPrompter(t, map[string]string{
+			"runtime": "python312",
+		}, nil)
+
+		cmd := &cobra.Command{}
+		cmdInputs, err := buildInputs(cmd, extension)
+		require.NoError(t, err)
+		require.NoError(t, cmd.Flags().Set("name", "test-name"))
+
+		clierr := cmdInputs.promptMissingFlags(cmd.Flags())
+		require.Nil(t, clierr)
+		require.Equal(t, map[string]string{"runtime": "nodejs22"}, prompted)
+		require.Equal(t, "test-name", cmd.Flags().Lookup("name").Value.String())
+		require.Equal(t, "python312", cmd.Flags().Lookup("runtime").Value.String())
+		require.True(t, cmd.Flags().Lookup("runtime").Changed)
+	})
+
+	t.Run("leave skipped optional flags unset", func(t *testing.T) {
+		fixInteractiveTerminal(t, true)
+		prompted := fixFlagPrompter(t, map[string]string{
+			"name":      "test-name",
+			"namespace": "",
+		}, nil)
+
+		cmd := &cobra.Command{}
+		cmdInputs, err := buildInputs(cmd, types.Extension{
+			Metadata: types.Metadata{Name: "create"},
+			Flags: []types.Flag{
+				{
+					Type:     parameters.StringCustomType,
+					Name:     "name",
+					Required: true,
+					Prompt:   true,
+				},
+				{
+					Type:   parameters.StringCustomType,
+					Name:   "namespace",
+					Prompt: true,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		clierr := cmdInputs.promptMissingFlags(cmd.Flags())
+		require.Nil(t, clierr)
+		require.Equal(t, map[string]string{"name": "", "namespace": ""}, prompted)
+		require.True(t, cmd.Flags().Lookup("name").Changed)
+		require.Equal(t, "test-name", cmd.Flags().Lookup("name").Value.String())
+		require.False(t, cmd.Flags().Lookup("namespace").Changed)
+	})
+
+	t.Run("skip prompts in non-interactive terminal", func(t *testing.T) {
+		fixInteractiveTerminal(t, false)
+		prompted := fixFlagPrompter(t, map[string]string{}, nil)
+
+		cmd := &cobra.Command{}
+		cmdInputs, err := buildInputs(cmd, extension)
+		require.NoError(t, err)
+
+		clierr := cmdInputs.promptMissingFlags(cmd.Flags())
+		require.Nil(t, clierr)
+		require.Empty(t, prompted)
+		require.False(t, cmd.Flags().Lookup("name").Changed)
+	})
+
+	t.Run("prompt error", func(t *testing.T) {
+		fixInteractiveTerminal(t, true)
+		fixFlagPrompter(t, map[string]string{}, errors.New("no value was provided"))
+
+		cmd := &cobra.Command{}
+		cmdInputs, err := buildInputs(cmd, extension)
+		require.NoError(t, err)
+
+		clierr := cmdInputs.promptMissingFlags(cmd.Flags())
+		require.Equal(t, clierror.Wrap(errors.New("no value was provided"),
+			clierror.New("failed to read value of the 'name' flag")), clierr)
+	})
+
+	t.Run("wrong prompted value", func(t *testing.T) {
+		fixInteractiveTerminal(t, true)
+		fixFlagPrompter(t, map[string]string{"replicas": "one"}, nil)
+
+		cmd := &cobra.Command{}
+		cmdInputs, err := buildInputs(cmd, types.Extension{
+			Metadata: types.Metadata{Name: "scale"},
+			Flags: []types.Flag{
+				{
+					Type:   parameters.IntCustomType,
+					Name:   "replicas",
+					Prompt: true,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		clierr := cmdInputs.promptMissingFlags(cmd.Flags())
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to set value of the 'replicas' flag")
+	})
+}
+
+func Test_newFlagPrompter(t *testing.T) {
+	require.IsType(t, &prompt.OneOfStringList{}, newFlagPrompter(types.Flag{
+		Type:          parameters.EnumCustomType,
+		AllowedValues: []string{"a", "b"},
+	}, ""))
+	require.IsType(t, &boolFlagPrompter{}, newFlagPrompter(types.Flag{Type: parameters.BoolCustomType}, "true"))
+	require.IsType(t, &prompt.String{}, newFlagPrompter(types.Flag{Type: parameters.StringCustomType}, ""))
+	require.IsType(t, &prompt.String{}, newFlagPrompter(types.Flag{Type: parameters.IntCustomType}, "1"))
+
+	// only optional flags can be skipped
+	require.Equal(t, prompt.NewString("Value of the 'name' flag", ""),
+		newFlagPrompter(types.Flag{Type: parameters.StringCustomType, Name: "name", Required: true}, ""))
+	require.Equal(t, prompt.NewOptionalString("Value of the 'name' flag", ""),
+		newFlagPrompter(types.Flag{Type: parameters.StringCustomType, Name: "name"}, ""))
+	require.Equal(t, prompt.NewOptionalOneOfStringList("Value of the 'runtime' flag:", "Type the value or leave empty to skip: ", []string{"a"}),
+		newFlagPrompter(types.Flag{Type: parameters.EnumCustomType, Name: "runtime", AllowedValues: []string{"a"}}, ""))
+}
+
+func Test_promptMessage(t *testing.T) {
+	require.Equal(t, "Function name", promptMessage(types.Flag{Name: "name", Description: "Name", PromptMessage: "Function name"}))
+	require.Equal(t, "Name", promptMessage(types.Flag{Name: "name", Description: "Name"}))
+	require.Equal(t, "Value of the 'name' flag", promptMessage(types.Flag{Name: "name"}))
+}
+
+func fixInteractiveTerminal(t *testing.T, interactive bool) {
+	oldIsInteractiveTerminal := isInteractiveTerminal
+	isInteractiveTerminal = func() bool { return interactive }
+	t.Cleanup(func() { isInteractiveTerminal = oldIsInteractiveTerminal })
+}
+
+// fixFlagPrompter overwrites prompts with answers from the given map and returns map with prompted flags and their default values
+func fixFlagPrompter(t *testing.T, answers map[string]string, err error) map[string]string {
+	prompted := map[string]string{}
+	oldNewFlagPrompter := newFlagPrompter
+	newFlagPrompter = func(commandFlag types.Flag, defaultValue string) flagPrompter {
+		prompted[commandFlag.Name] = defaultValue
+		return &fakeFlagPrompter{value: answers[commandFlag.Name], err: err}
+	}
+	t.Cleanup(func() { newFlagPrompter = oldNewFlagPrompter })
+
+	return prompted
+}
+
+type fakeFlagPrompter struct {
+	value string
+	err   error
+}
+
+func (p *fakeFlagPrompter) Prompt() (string, error) {
+	return p.value, p.err
+}
@@ -0,0 +1,110 @@
+package extensions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+// setStepsRun sets command runs executing all steps one by one and cleanup steps if one of them fails
+func setStepsRun(cmd *cobra.Command, extension types.Extension, cmdInputs *inputs, availableActions types.ActionsMap) {
+	for _, step := range slices.Concat(extension.Steps, extension.CleanupSteps) {
+		if _, ok := availableActions[step.Action]; !ok {
+			// action not found
+			// set unsupported action run to inform user
+			cmd.Run = newUnsupportedActionRun(step.Action)
+			return
+		}
+	}
+
+	cmd.PreRun = func(_ *cobra.Command, _ []string) {
+		// ask for missing flags values in the interactive terminal
+		clierror.Check(cmdInputs.promptMissingFlags(cmd.Flags()))
+
+		// check required flags and flags values
+		// actions are configured right before running every step to use outputs of previous steps
+		clierror.Check(flags.Validate(cmd.Flags(),
+			append([]flags.Rule{flags.MarkRequired(cmdInputs.requiredFlags...)}, cmdInputs.flagRules...)...,
+		))
+	}
+
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		clierr := runSteps(cmd, args, cmdInputs, extension.Steps, availableActions)
+		if clierr != nil && len(extension.CleanupSteps) != 0 {
+			runCleanupSteps(cmd, args, cmdInputs, extension.CleanupSteps, availableActions)
+		}
+
+		clierror.Check(clierr)
+	}
+}
+
+// runSteps runs steps one by one and stores outputs of every step under the .steps.<id>.outputs overwrite
+func runSteps(cmd *cobra.Command, args []string, cmdInputs *inputs, steps []types.Step, availableActions types.ActionsMap) clierror.Error {
+	stepsOverwrites, ok := cmdInputs.overwrites["steps"].(map[string]interface{})
+	if !ok {
+		stepsOverwrites = map[string]interface{}{}
+		cmdInputs.overwrites["steps"] = stepsOverwrites
+	}
+
+	for i, step := range steps {
+		action := availableActions[step.Action]
+
+		clierr := runStep(cmd, args, cmdInputs, action, step)
+		if clierr != nil {
+			return clierror.WrapE(clierr, clierror.New(fmt.Sprintf("failed to run step %s", stepName(i, step))))
+		}
+
+		if step.ID != "" {
+			stepsOverwrites[step.ID] = map[string]interface{}{
+				"outputs": actionOutputs(action),
+			}
+		}
+	}
+
+	return nil
+}
+
+// runStep configures action with the current overwrites (including outputs of previous steps) and runs it
+func runStep(cmd *cobra.Command, args []string, cmdInputs *inputs, action types.Action, step types.Step) clierror.Error {
+	clierr := cmdInputs.configure(action, step.Config)
+	if clierr != nil {
+		return clierr
+	}
+
+	return action.Run(cmd, args)
+}
+
+// runCleanupSteps runs all cleanup steps, even if some of them fail, and prints their errors
+func runCleanupSteps(cmd *cobra.Command, args []string, cmdInputs *inputs, steps []types.Step, availableActions types.ActionsMap) {
+	out.Errln("Running cleanup steps")
+	for i, step := range steps {
+		clierr := runStep(cmd, args, cmdInputs, availableActions[step.Action], step)
+		if clierr != nil {
+			out.Errfln("Cleanup step %s failed: %s", stepName(i, step), strings.TrimSpace(clierr.String()))
+		}
+	}
+}
+
+func actionOutputs(action types.Action) map[string]interface{} {
+	actionWithOutputs, ok := action.(types.ActionWithOutputs)
+	if !ok || actionWithOutputs.Outputs() == nil {
+		return map[string]interface{}{}
+	}
+
+	return actionWithOutputs.Outputs()
+}
+
+// returns human-readable step identifier
+func stepName(index int, step types.Step) string {
+	if step.ID != "" {
+		return fmt.Sprintf("'%s'", step.ID)
+	}
+
+	return fmt.Sprintf("%d (%s)", index+1, step.Action)
+}
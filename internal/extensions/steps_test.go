@@ -0,0 +1,157 @@
+package extensions
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_setStepsRun(t *testing.T) {
+	t.Run("run steps with outputs", func(t *testing.T) {
+		buildMock := &mockActionWithOutputs{
+			outputs: map[string]interface{}{
+				"image": "registry/app:1",
+			},
+		}
+		deployMock := &mockAction{}
+
+		cmd, err := buildCommand(fixStepsExtension(), types.ActionsMap{
+			"build":   buildMock,
+			"deploy":  deployMock,
+			"cleanup": &mockAction{},
+		})
+		require.NoError(t, err)
+
+		cmd.SetArgs([]string{"app"})
+		err = cmd.Execute()
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"app"}, buildMock.runArgs)
+		require.Equal(t, []string{"app"}, deployMock.runArgs)
+		require.Equal(t, map[string]interface{}{"image": "${{ .steps.build.outputs.image }}"}, deployMock.configureArg)
+		require.Equal(t, map[string]interface{}{
+			"build": map[string]interface{}{
+				"outputs": map[string]interface{}{
+					"image": "registry/app:1",
+				},
+			},
+			"deploy": map[string]interface{}{
+				// action without outputs
+				"outputs": map[string]interface{}{},
+			},
+		}, deployMock.configureOverwritesArg["steps"])
+	})
+
+	t.Run("unsupported step action", func(t *testing.T) {
+		cmd, err := buildCommand(fixStepsExtension(), types.ActionsMap{
+			"build": &mockAction{},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cmd.Run)
+		require.Nil(t, cmd.PreRun)
+	})
+}
+
+func Test_runSteps(t *testing.T) {
+	t.Run("stop on first failed step", func(t *testing.T) {
+		buildMock := &mockAction{
+			runError: clierror.New("build failed"),
+		}
+		deployMock := &mockAction{}
+		extension := fixStepsExtension()
+
+		cmdInputs, err := buildInputs(&cobra.Command{}, extension)
+		require.NoError(t, err)
+
+		clierr := runSteps(&cobra.Command{}, []string{}, cmdInputs, extension.Steps, types.ActionsMap{
+			"build":  buildMock,
+			"deploy": deployMock,
+		})
+		require.Equal(t, clierror.WrapE(clierror.New("build failed"), clierror.New("failed to run step 'build'")), clierr)
+		require.NotNil(t, buildMock.runArgs)
+		require.Nil(t, deployMock.runArgs)
+	})
+
+	t.Run("name steps without id", func(t *testing.T) {
+		extension := fixStepsExtension()
+		extension.Steps[1].ID = ""
+
+		cmdInputs, err := buildInputs(&cobra.Command{}, extension)
+		require.NoError(t, err)
+
+		clierr := runSteps(&cobra.Command{}, []string{}, cmdInputs, extension.Steps, types.ActionsMap{
+			"build": &mockAction{},
+			"deploy": &mockAction{
+				configureError: clierror.New("wrong config"),
+			},
+		})
+		require.Equal(t, clierror.WrapE(clierror.New("wrong config"), clierror.New("failed to run step 2 (deploy)")), clierr)
+	})
+}
+
+func Test_runCleanupSteps(t *testing.T) {
+	t.Run("run all cleanup steps", func(t *testing.T) {
+		firstMock := &mockAction{
+			runError: clierror.New("cleanup failed"),
+		}
+		secondMock := &mockAction{}
+
+		cmdInputs, err := buildInputs(&cobra.Command{}, fixStepsExtension())
+		require.NoError(t, err)
+
+		runCleanupSteps(&cobra.Command{}, []string{}, cmdInputs, []types.Step{
+			{Action: "first"},
+			{Action: "second"},
+		}, types.ActionsMap{
+			"first":  firstMock,
+			"second": secondMock,
+		})
+		require.NotNil(t, firstMock.runArgs)
+		require.NotNil(t, secondMock.runArgs)
+	})
+}
+
+func fixStepsExtension() types.Extension {
+	return types.Extension{
+		Metadata: types.Metadata{
+			Name: "push",
+		},
+		Args: &types.Args{
+			Type: "string",
+		},
+		Steps: []types.Step{
+			{
+				ID:     "build",
+				Action: "build",
+				Config: map[string]interface{}{
+					"image": "${{ .args.value }}",
+				},
+			},
+			{
+				ID:     "deploy",
+				Action: "deploy",
+				Config: map[string]interface{}{
+					"image": "${{ .steps.build.outputs.image }}",
+				},
+			},
+		},
+		CleanupSteps: []types.Step{
+			{
+				Action: "cleanup",
+			},
+		},
+	}
+}
+
+type mockActionWithOutputs struct {
+	mockAction
+
+	outputs map[string]interface{}
+}
+
+func (m *mockActionWithOutputs) Outputs() map[string]interface{} {
+	return m.outputs
+}
@@ -0,0 +1,174 @@
+package extensions
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// env containing comma-separated list of namespaces from which extensions are loaded, "*" allows all namespaces
+	ExtensionsAllowedNamespacesEnv = "KYMA_EXTENSIONS_ALLOWED_NAMESPACES"
+	// env containing list of PEM files with public keys separated by the os.PathListSeparator
+	// if set, only extensions signed with one of these keys are loaded
+	ExtensionsPublicKeysEnv = "KYMA_EXTENSIONS_PUBLIC_KEYS"
+
+	defaultExtensionsNamespace = "kyma-system"
+	allNamespaces              = "*"
+	digestPrefix               = "sha256:"
+)
+
+// trustPolicy decides which extensions from the cluster can be loaded
+type trustPolicy struct {
+	allowedNamespaces []string
+	publicKeys        []crypto.PublicKey
+	// sha256 of all public keys used to identify the policy
+	keysDigest string
+}
+
+// loadTrustPolicy builds the policy based on the KYMA_EXTENSIONS_ALLOWED_NAMESPACES and KYMA_EXTENSIONS_PUBLIC_KEYS envs
+func loadTrustPolicy() (*trustPolicy, error) {
+	policy := &trustPolicy{
+		allowedNamespaces: []string{defaultExtensionsNamespace},
+	}
+
+	if namespaces := os.Getenv(ExtensionsAllowedNamespacesEnv); namespaces != "" {
+		policy.allowedNamespaces = []string{}
+		for _, namespace := range strings.Split(namespaces, ",") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				policy.allowedNamespaces = append(policy.allowedNamespaces, namespace)
+			}
+		}
+	}
+
+	keysHash := sha256.New()
+	for _, path := range filepath.SplitList(os.Getenv(ExtensionsPublicKeysEnv)) {
+		if path == "" {
+			continue
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read public key from the %s env", ExtensionsPublicKeysEnv)
+		}
+
+		keys, err := parsePublicKeys(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse public key file '%s'", path)
+		}
+
+		policy.publicKeys = append(policy.publicKeys, keys...)
+		keysHash.Write(data)
+	}
+
+	if len(policy.publicKeys) != 0 {
+		policy.keysDigest = hex.EncodeToString(keysHash.Sum(nil))
+	}
+
+	return policy, nil
+}
+
+// String returns the policy identifier used to invalidate cached extensions when the policy changes
+func (p *trustPolicy) String() string {
+	return strings.Join(p.allowedNamespaces, ",") + ";" + p.keysDigest
+}
+
+// verify checks if the extension configmap comes from the allowed namespace, its data matches the digest annotation,
+// and its data is signed with one of trusted keys if they are configured
+// returns true if the signature is verified
+func (p *trustPolicy) verify(cm *v1.ConfigMap) (bool, error) {
+	if !slices.Contains(p.allowedNamespaces, allNamespaces) && !slices.Contains(p.allowedNamespaces, cm.GetNamespace()) {
+		return false, errors.Newf("namespace '%s' is not allowed, add it to the %s env to trust extensions from it",
+			cm.GetNamespace(), ExtensionsAllowedNamespacesEnv)
+	}
+
+	data := []byte(cm.Data[types.ExtensionCMDataKey])
+	digest := sha256.Sum256(data)
+
+	if expectedDigest, ok := cm.GetAnnotations()[types.ExtensionCMDigestAnnotation]; ok {
+		if expectedDigest != digestPrefix+hex.EncodeToString(digest[:]) {
+			return false, errors.Newf("extension data does not match the %s annotation", types.ExtensionCMDigestAnnotation)
+		}
+	}
+
+	if len(p.publicKeys) == 0 {
+		// signature verification is disabled
+		return false, nil
+	}
+
+	encodedSignature, ok := cm.GetAnnotations()[types.ExtensionCMSignatureAnnotation]
+	if !ok {
+		return false, errors.Newf("missing the %s annotation required by the %s env",
+			types.ExtensionCMSignatureAnnotation, ExtensionsPublicKeysEnv)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to decode the %s annotation", types.ExtensionCMSignatureAnnotation)
+	}
+
+	for _, key := range p.publicKeys {
+		if verifySignature(key, data, digest[:], signature) {
+			return true, nil
+		}
+	}
+
+	return false, errors.Newf("signature is not valid for any of trusted public keys from the %s env", ExtensionsPublicKeysEnv)
+}
+
+func verifySignature(key crypto.PublicKey, data, digest, signature []byte) bool {
+	switch typedKey := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(typedKey, data, signature)
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(typedKey, digest, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(typedKey, crypto.SHA256, digest, signature) == nil
+	default:
+		return false
+	}
+}
+
+// parsePublicKeys returns all PKIX public keys (ed25519, ECDSA or RSA) from the PEM data
+func parsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	keys := []crypto.PublicKey{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		switch key.(type) {
+		case ed25519.PublicKey, *ecdsa.PublicKey, *rsa.PublicKey:
+			keys = append(keys, key)
+		default:
+			return nil, errors.Newf("unsupported public key type %T", key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM encoded public key found")
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,190 @@
+package extensions
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_loadTrustPolicy(t *testing.T) {
+	t.Run("default policy", func(t *testing.T) {
+		t.Setenv(ExtensionsAllowedNamespacesEnv, "")
+		t.Setenv(ExtensionsPublicKeysEnv, "")
+
+		policy, err := loadTrustPolicy()
+		require.NoError(t, err)
+		require.Equal(t, []string{"kyma-system"}, policy.allowedNamespaces)
+		require.Empty(t, policy.publicKeys)
+		require.Equal(t, "kyma-system;", policy.String())
+	})
+
+	t.Run("policy from envs", func(t *testing.T) {
+		_, keyPath := fixEd25519Key(t)
+		t.Setenv(ExtensionsAllowedNamespacesEnv, "kyma-system, team-a")
+		t.Setenv(ExtensionsPublicKeysEnv, keyPath)
+
+		policy, err := loadTrustPolicy()
+		require.NoError(t, err)
+		require.Equal(t, []string{"kyma-system", "team-a"}, policy.allowedNamespaces)
+		require.Len(t, policy.publicKeys, 1)
+		require.NotEmpty(t, policy.keysDigest)
+	})
+
+	t.Run("handle wrong public key file", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), "key.pem")
+		require.NoError(t, os.WriteFile(keyPath, []byte("not a key"), os.ModePerm))
+		t.Setenv(ExtensionsPublicKeysEnv, keyPath)
+
+		policy, err := loadTrustPolicy()
+		require.Equal(t, errors.Newf("failed to parse public key file '%s': no PEM encoded public key found", keyPath), err)
+		require.Nil(t, policy)
+	})
+}
+
+func Test_trustPolicy_verify(t *testing.T) {
+	data := testExtensionString
+
+	t.Run("allow configmap from the allowed namespace", func(t *testing.T) {
+		policy := &trustPolicy{allowedNamespaces: []string{"kyma-system"}}
+
+		signed, err := policy.verify(fixTrustConfigMap("kyma-system", data, nil))
+		require.NoError(t, err)
+		require.False(t, signed)
+	})
+
+	t.Run("allow all namespaces", func(t *testing.T) {
+		policy := &trustPolicy{allowedNamespaces: []string{"*"}}
+
+		_, err := policy.verify(fixTrustConfigMap("default", data, nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("refuse configmap from other namespace", func(t *testing.T) {
+		policy := &trustPolicy{allowedNamespaces: []string{"kyma-system"}}
+
+		_, err := policy.verify(fixTrustConfigMap("default", data, nil))
+		require.EqualError(t, err, "namespace 'default' is not allowed, add it to the KYMA_EXTENSIONS_ALLOWED_NAMESPACES env to trust extensions from it")
+	})
+
+	t.Run("verify digest", func(t *testing.T) {
+		policy := &trustPolicy{allowedNamespaces: []string{"kyma-system"}}
+		digest := sha256.Sum256([]byte(data))
+
+		_, err := policy.verify(fixTrustConfigMap("kyma-system", data, map[string]string{
+			types.ExtensionCMDigestAnnotation: "sha256:" + hex.EncodeToString(digest[:]),
+		}))
+		require.NoError(t, err)
+
+		_, err = policy.verify(fixTrustConfigMap("kyma-system", data, map[string]string{
+			types.ExtensionCMDigestAnnotation: "sha256:0000",
+		}))
+		require.EqualError(t, err, "extension data does not match the kyma-cli/extension-digest annotation")
+	})
+
+	t.Run("verify signatures", func(t *testing.T) {
+		ed25519Key, _ := fixEd25519Key(t)
+		ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		digest := sha256.Sum256([]byte(data))
+		ecdsaSignature, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, digest[:])
+		require.NoError(t, err)
+		rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+
+		policy := &trustPolicy{
+			allowedNamespaces: []string{"kyma-system"},
+			publicKeys:        []crypto.PublicKey{ed25519Key.Public(), ecdsaKey.Public(), rsaKey.Public()},
+		}
+
+		for _, signature := range [][]byte{ed25519.Sign(ed25519Key, []byte(data)), ecdsaSignature, rsaSignature} {
+			signed, err := policy.verify(fixTrustConfigMap("kyma-system", data, map[string]string{
+				types.ExtensionCMSignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+			}))
+			require.NoError(t, err)
+			require.True(t, signed)
+		}
+	})
+
+	t.Run("refuse wrong or missing signature", func(t *testing.T) {
+		key, _ := fixEd25519Key(t)
+		policy := &trustPolicy{
+			allowedNamespaces: []string{"kyma-system"},
+			publicKeys:        []crypto.PublicKey{key.Public()},
+		}
+
+		_, err := policy.verify(fixTrustConfigMap("kyma-system", data, nil))
+		require.EqualError(t, err, "missing the kyma-cli/extension-signature annotation required by the KYMA_EXTENSIONS_PUBLIC_KEYS env")
+
+		_, err = policy.verify(fixTrustConfigMap("kyma-system", data, map[string]string{
+			types.ExtensionCMSignatureAnnotation: base64.StdEncoding.EncodeToString(ed25519.Sign(key, []byte("other data"))),
+		}))
+		require.EqualError(t, err, "signature is not valid for any of trusted public keys from the KYMA_EXTENSIONS_PUBLIC_KEYS env")
+	})
+}
+
+func Test_parseCommandExtensionConfigMaps_trust(t *testing.T) {
+	t.Setenv(ExtensionsAllowedNamespacesEnv, "")
+	t.Setenv(ExtensionsPublicKeysEnv, "")
+
+	untrusted := fixTestExtensionConfigMap("untrusted", strings.ReplaceAll(testExtensionString, "resource", "other"))
+	untrusted.Namespace = "default"
+
+	extensions, rejected, err := parseCommandExtensionConfigMaps(&corev1.ConfigMapList{
+		Items: []corev1.ConfigMap{
+			*fixTestExtensionConfigMap("cm1", testExtensionString),
+			*untrusted,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []RejectedExtension{
+		{
+			ConfigMapName:      "untrusted",
+			ConfigMapNamespace: "default",
+			Refused:            true,
+			Reason:             "namespace 'default' is not allowed, add it to the KYMA_EXTENSIONS_ALLOWED_NAMESPACES env to trust extensions from it",
+		},
+	}, rejected)
+	require.Len(t, extensions, 1)
+	require.Equal(t, "cm1", extensions[0].ConfigMapName)
+}
+
+func fixTrustConfigMap(namespace, data string, annotations map[string]string) *corev1.ConfigMap {
+	cm := fixTestExtensionConfigMap("cm", data)
+	cm.Namespace = namespace
+	cm.Annotations = annotations
+	return cm
+}
+
+// returns the private key and the path to the PEM file with its public key
+func fixEd25519Key(t *testing.T) (ed25519.PrivateKey, string) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), os.ModePerm))
+
+	return privateKey, keyPath
+}
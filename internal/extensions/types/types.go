@@ -2,8 +2,10 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 	"slices"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/extensions/errors"
 	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
@@ -14,6 +16,11 @@ const (
 	ExtensionCMLabelKey   = "kyma-cli/extension"
 	ExtensionCMLabelValue = "commands"
 	ExtensionCMDataKey    = "kyma-commands.yaml"
+
+	// annotation with the digest of the extension data in format sha256:<hex>
+	ExtensionCMDigestAnnotation = "kyma-cli/extension-digest"
+	// annotation with the base64 encoded signature of the extension data
+	ExtensionCMSignatureAnnotation = "kyma-cli/extension-signature"
 )
 
 type Action interface {
@@ -21,6 +28,13 @@ type Action interface {
 	Run(*cobra.Command, []string) clierror.Error
 }
 
+// ActionWithOutputs is implemented by actions returning values that can be used in templates of next steps
+type ActionWithOutputs interface {
+	Action
+	// returns outputs of the last run
+	Outputs() map[string]interface{}
+}
+
 // map of allowed action commands in format ID: ACTION
 type ActionsMap map[string]Action
 
@@ -29,9 +43,22 @@ type ActionConfig = map[string]interface{}
 type ActionConfigOverwrites = map[string]interface{}
 
 type ConfigmapCommandExtension struct {
-	ConfigMapName      string
-	ConfigMapNamespace string
-	Extension          Extension
+	ConfigMapName      string `yaml:"configMapName,omitempty"`
+	ConfigMapNamespace string `yaml:"configMapNamespace,omitempty"`
+	// path to the local file the extension is loaded from, empty for extensions from the cluster
+	FilePath string `yaml:"filePath,omitempty"`
+	// true if the signature of the configmap is verified with one of trusted public keys
+	Signed    bool      `yaml:"signed,omitempty"`
+	Extension Extension `yaml:"extension"`
+}
+
+// Source returns human-readable information about the place the extension comes from
+func (e *ConfigmapCommandExtension) Source() string {
+	if e.FilePath != "" {
+		return fmt.Sprintf("file '%s'", e.FilePath)
+	}
+
+	return fmt.Sprintf("configmap '%s/%s'", e.ConfigMapNamespace, e.ConfigMapName)
 }
 
 type Metadata struct {
@@ -52,18 +79,33 @@ func (m *Metadata) Validate() error {
 }
 
 type Args struct {
-	// type of the argument and config field
-	// TODO: support many args by adding new type, like `stringArray`
+	// type of the argument and config field, array types accept many args
 	Type parameters.ConfigFieldType `yaml:"type"`
 	// mark if args are required to run command
 	Optional bool `yaml:"optional"`
+	// minimal number of args, supported only for array types
+	MinCount *int `yaml:"minCount"`
+	// maximal number of args, supported only for array types
+	MaxCount *int `yaml:"maxCount"`
 }
 
 func (a *Args) Validate() error {
-	if !slices.Contains(parameters.ValidTypes, a.Type) {
+	if !slices.Contains(parameters.ValidTypes, a.Type) || a.Type == parameters.EnumCustomType {
 		return errors.New(fmt.Sprintf("unknown type '%s'", a.Type))
 	}
 
+	if (a.MinCount != nil || a.MaxCount != nil) && !slices.Contains(parameters.ArrayTypes, a.Type) {
+		return errors.Newf("minCount and maxCount are not supported for type '%s'", a.Type)
+	}
+
+	if a.MinCount != nil && *a.MinCount < 0 {
+		return errors.New("minCount can't be negative")
+	}
+
+	if a.MinCount != nil && a.MaxCount != nil && *a.MinCount > *a.MaxCount {
+		return errors.New("minCount can't be greater than maxCount")
+	}
+
 	return nil
 }
 
@@ -80,6 +122,27 @@ type Flag struct {
 	DefaultValue *string `yaml:"default"`
 	// mark if flag is required
 	Required bool `yaml:"required"`
+	// list of values accepted by the enum flag
+	AllowedValues []string `yaml:"allowedValues"`
+	// regular expression every value of the string or stringArray flag must match
+	Pattern string `yaml:"pattern"`
+	// minimal value of the int or intArray flag
+	Min *int64 `yaml:"min"`
+	// maximal value of the int or intArray flag
+	Max *int64 `yaml:"max"`
+	// ask for the flag value in the interactive terminal if it's not set
+	Prompt bool `yaml:"prompt"`
+	// optional message displayed when asking for the flag value
+	PromptMessage string `yaml:"promptMessage"`
+}
+
+// types of flags with values that can be provided in the interactive prompt
+var promptTypes = []parameters.ConfigFieldType{
+	parameters.StringCustomType,
+	parameters.PathCustomType,
+	parameters.IntCustomType,
+	parameters.BoolCustomType,
+	parameters.EnumCustomType,
 }
 
 func (f *Flag) Validate() error {
@@ -92,10 +155,58 @@ func (f *Flag) Validate() error {
 		errs = append(errs, errors.Newf("unknown type '%s'", f.Type))
 	}
 
+	errs = append(errs, f.validateRestrictions()...)
+
 	return errors.JoinWithSeparator(", ", errs...)
 }
 
+func (f *Flag) validateRestrictions() []error {
+	var errs []error
+	if f.Type == parameters.EnumCustomType && len(f.AllowedValues) == 0 {
+		errs = append(errs, errors.New("empty allowedValues for type 'enum'"))
+	}
+
+	if f.Type != parameters.EnumCustomType && len(f.AllowedValues) != 0 {
+		errs = append(errs, errors.Newf("allowedValues are not supported for type '%s'", f.Type))
+	}
+
+	if f.Type == parameters.EnumCustomType && f.DefaultValue != nil && *f.DefaultValue != "" &&
+		!slices.Contains(f.AllowedValues, *f.DefaultValue) {
+		errs = append(errs, errors.Newf("default value '%s' is not one of allowedValues", *f.DefaultValue))
+	}
+
+	if f.Pattern != "" && !slices.Contains([]parameters.ConfigFieldType{parameters.StringCustomType, parameters.StringArrayCustomType}, f.Type) {
+		errs = append(errs, errors.Newf("pattern is not supported for type '%s'", f.Type))
+	}
+
+	if _, err := regexp.Compile(f.Pattern); err != nil {
+		errs = append(errs, errors.Newf("wrong pattern: %s", err.Error()))
+	}
+
+	if (f.Min != nil || f.Max != nil) && !slices.Contains([]parameters.ConfigFieldType{parameters.IntCustomType, parameters.IntArrayCustomType}, f.Type) {
+		errs = append(errs, errors.Newf("min and max are not supported for type '%s'", f.Type))
+	}
+
+	if f.Min != nil && f.Max != nil && *f.Min > *f.Max {
+		errs = append(errs, errors.New("min can't be greater than max"))
+	}
+
+	if f.Prompt && !slices.Contains(promptTypes, f.Type) {
+		errs = append(errs, errors.Newf("prompt is not supported for type '%s'", f.Type))
+	}
+
+	if !f.Prompt && f.PromptMessage != "" {
+		errs = append(errs, errors.New("promptMessage can't be used without prompt"))
+	}
+
+	return errs
+}
+
 type Extension struct {
+	// version of the extension schema, supported only in the root command
+	APIVersion string `yaml:"apiVersion,omitempty"`
+	// minimal CLI version required to run the extension, supported only in the root command
+	MinCLIVersion string `yaml:"minCliVersion,omitempty"`
 	// metadata (name, descriptions) for the command
 	Metadata Metadata `yaml:"metadata"`
 	// id of the functionality that cli will run when user use this command
@@ -106,16 +217,35 @@ type Extension struct {
 	Args *Args `yaml:"args"`
 	// additional config pass to the command
 	Config ActionConfig `yaml:"with"`
+	// list of actions run one by one instead of the single action
+	Steps []Step `yaml:"steps"`
+	// list of actions run when one of steps fails
+	CleanupSteps []Step `yaml:"cleanupSteps"`
 	// list of sub commands
 	SubCommands []Extension `yaml:"subCommands"`
 }
 
+type Step struct {
+	// optional id used to reference step outputs in templates of next steps
+	ID string `yaml:"id"`
+	// id of the functionality that cli will run in this step
+	Action string `yaml:"uses"`
+	// config passed to the step action
+	Config ActionConfig `yaml:"with"`
+}
+
 func (e *Extension) Validate() error {
 	return e.validateWithPath(".")
 }
 
 func (e *Extension) validateWithPath(path string) error {
 	var errs []error
+	if e.MinCLIVersion != "" {
+		if _, err := semver.NewVersion(e.MinCLIVersion); err != nil {
+			errs = append(errs, errors.Newf("wrong %sminCliVersion: %s", path, err.Error()))
+		}
+	}
+
 	if metaErr := e.Metadata.Validate(); metaErr != nil {
 		errs = append(errs, errors.Newf("wrong %smetadata: %s", path, metaErr.Error()))
 	}
@@ -132,6 +262,10 @@ func (e *Extension) validateWithPath(path string) error {
 		}
 	}
 
+	if stepsErr := e.validateSteps(); stepsErr != nil {
+		errs = append(errs, errors.Newf("wrong %ssteps: %s", path, stepsErr.Error()))
+	}
+
 	for i := range e.SubCommands {
 		subCmdErr := e.SubCommands[i].validateWithPath(fmt.Sprintf("%ssubCommands[%d].", path, i))
 		if subCmdErr != nil {
@@ -141,3 +275,28 @@ func (e *Extension) validateWithPath(path string) error {
 
 	return errors.NewList(errs...)
 }
+
+func (e *Extension) validateSteps() error {
+	var errs []error
+	if e.Action != "" && len(e.Steps) != 0 {
+		errs = append(errs, errors.New("uses and steps can't be used together"))
+	}
+
+	if len(e.Steps) == 0 && len(e.CleanupSteps) != 0 {
+		errs = append(errs, errors.New("cleanupSteps can't be used without steps"))
+	}
+
+	ids := []string{}
+	for _, step := range slices.Concat(e.Steps, e.CleanupSteps) {
+		if step.Action == "" {
+			errs = append(errs, errors.New("empty uses"))
+		}
+
+		if step.ID != "" && slices.Contains(ids, step.ID) {
+			errs = append(errs, errors.Newf("duplicated id '%s'", step.ID))
+		}
+		ids = append(ids, step.ID)
+	}
+
+	return errors.JoinWithSeparator(", ", errs...)
+}
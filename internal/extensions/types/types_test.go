@@ -85,6 +85,145 @@ func TestExtension_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "validation error - wrong args count",
+			wantErr: "wrong .args: minCount and maxCount are not supported for type 'string'\n" +
+				"wrong .subCommands[0].args: minCount can't be greater than maxCount",
+			extension: Extension{
+				Metadata: Metadata{
+					Name: "function",
+				},
+				Args: &Args{
+					Type:     "string",
+					MinCount: toPtr(1),
+				},
+				SubCommands: []Extension{
+					{
+						Metadata: Metadata{
+							Name: "delete",
+						},
+						Args: &Args{
+							Type:     "stringArray",
+							MinCount: toPtr(2),
+							MaxCount: toPtr(1),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "validation error - wrong flags restrictions",
+			wantErr: "wrong .flags: empty allowedValues for type 'enum'\n" +
+				"wrong .flags: default value 'java' is not one of allowedValues\n" +
+				"wrong .flags: allowedValues are not supported for type 'int', min can't be greater than max\n" +
+				"wrong .flags: pattern is not supported for type 'int', wrong pattern: error parsing regexp: missing closing ]: `[a-z`",
+			extension: Extension{
+				Metadata: Metadata{
+					Name: "function",
+				},
+				Flags: []Flag{
+					{
+						Type: "enum",
+						Name: "empty-enum",
+					},
+					{
+						Type:          "enum",
+						Name:          "runtime",
+						AllowedValues: []string{"nodejs22"},
+						DefaultValue:  toPtr("java"),
+					},
+					{
+						Type:          "int",
+						Name:          "replicas",
+						AllowedValues: []string{"1"},
+						Min:           toPtr[int64](2),
+						Max:           toPtr[int64](1),
+					},
+					{
+						Type:    "int",
+						Name:    "port",
+						Pattern: "[a-z",
+					},
+				},
+			},
+		},
+		{
+			name: "validation error - wrong flags prompt",
+			wantErr: "wrong .flags: prompt is not supported for type 'stringArray'\n" +
+				"wrong .flags: promptMessage can't be used without prompt",
+			extension: Extension{
+				Metadata: Metadata{
+					Name: "function",
+				},
+				Flags: []Flag{
+					{
+						Type:   "stringArray",
+						Name:   "env",
+						Prompt: true,
+					},
+					{
+						Type:          "string",
+						Name:          "name",
+						PromptMessage: "Function name",
+					},
+					{
+						Type:          "enum",
+						Name:          "runtime",
+						AllowedValues: []string{"nodejs22"},
+						Prompt:        true,
+						PromptMessage: "Function runtime",
+					},
+				},
+			},
+		},
+		{
+			name:    "validation error - wrong minCliVersion",
+			wantErr: "wrong .minCliVersion: invalid semantic version",
+			extension: Extension{
+				MinCLIVersion: "latest",
+				Metadata: Metadata{
+					Name: "app",
+				},
+			},
+		},
+		{
+			name: "validation error - wrong steps",
+			wantErr: "wrong .steps: uses and steps can't be used together, empty uses, duplicated id 'build'\n" +
+				"wrong .subCommands[0].steps: cleanupSteps can't be used without steps",
+			extension: Extension{
+				Metadata: Metadata{
+					Name: "app",
+				},
+				Action: "resource_create",
+				Steps: []Step{
+					{
+						ID:     "build",
+						Action: "registry_image_import",
+					},
+					{
+						// empty uses
+					},
+				},
+				CleanupSteps: []Step{
+					{
+						ID:     "build",
+						Action: "resource_delete",
+					},
+				},
+				SubCommands: []Extension{
+					{
+						Metadata: Metadata{
+							Name: "delete",
+						},
+						CleanupSteps: []Step{
+							{
+								Action: "resource_delete",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -97,3 +236,7 @@ func TestExtension_Validate(t *testing.T) {
 		})
 	}
 }
+
+func toPtr[T any](v T) *T {
+	return &v
+}
@@ -107,6 +107,23 @@ func MarkPrerequisites(flag string, prerequisiteFlags ...string) Rule {
 	}
 }
 
+// expect at least one of prerequisiteFlags to be used if flag is used
+func MarkOneOfPrerequisites(flag string, prerequisiteFlags ...string) Rule {
+	return func(flagSet *pflag.FlagSet) error {
+		if !anyOfFlagsChanges(flagSet, flag) {
+			// flag is not used
+			return nil
+		}
+
+		if !anyOfFlagsChanges(flagSet, prerequisiteFlags...) {
+			return fmt.Errorf("at least one of the flags from the group [%s] must be set when [%s] flag is used",
+				strings.Join(prerequisiteFlags, " "), flag)
+		}
+
+		return nil
+	}
+}
+
 func MarkUnsupported(flag string, message string) Rule {
 	return func(flagSet *pflag.FlagSet) error {
 		if anyOfFlagsChanges(flagSet, flag) {
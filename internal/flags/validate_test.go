@@ -214,6 +214,34 @@ func TestValidate(t *testing.T) {
 		})
 	})
 
+	t.Run("validate one of prerequisites", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			flagSet := fixTestFlagSet()
+			require.NoError(t, flagSet.Set("var1", "value"))
+			require.NoError(t, flagSet.Set("var3", "value"))
+
+			clierr := Validate(flagSet, MarkOneOfPrerequisites("var1", "var2", "var3"))
+			require.Nil(t, clierr)
+		})
+
+		t.Run("missing all", func(t *testing.T) {
+			flagSet := fixTestFlagSet()
+			require.NoError(t, flagSet.Set("var1", "value"))
+
+			expectedCliErr := fixValidationErr("at least one of the flags from the group [var2 var3] must be set when [var1] flag is used")
+
+			clierr := Validate(flagSet, MarkOneOfPrerequisites("var1", "var2", "var3"))
+			require.Equal(t, expectedCliErr, clierr)
+		})
+
+		t.Run("skip validation when flag is not set", func(t *testing.T) {
+			flagSet := fixTestFlagSet()
+
+			clierr := Validate(flagSet, MarkOneOfPrerequisites("var1", "var2", "var3"))
+			require.Nil(t, clierr)
+		})
+	})
+
 	t.Run("validate exclusive", func(t *testing.T) {
 		t.Run("ok - exclusive flags missing", func(t *testing.T) {
 			flagSet := fixTestFlagSet()
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 )
 
@@ -13,16 +14,26 @@ type RootlessDynamicClient struct {
 	ReturnErr       error
 	ReturnGetErr    error
 	ReturnRemoveErr error
+	ReturnPatchErr  error
 	ReturnWatchErr  error
 	ReturnGetObj    unstructured.Unstructured
+	ReturnPatchObj  unstructured.Unstructured
 	ReturnListObjs  *unstructured.UnstructuredList
 	ReturnWatcher   watch.Interface
+	// watchers returned by next Watch calls before the ReturnWatcher
+	ReturnWatchers []watch.Interface
 
 	// inputs summary
 	GetObjs     []unstructured.Unstructured
 	ListObjs    []unstructured.Unstructured
+	ListOpts    []rootlessdynamic.ListOptions
 	RemovedObjs []unstructured.Unstructured
 	ApplyObjs   []unstructured.Unstructured
+	PatchObjs   []unstructured.Unstructured
+	PatchTypes  []types.PatchType
+	PatchDatas  [][]byte
+	WatchObjs   []unstructured.Unstructured
+	WatchOpts   []rootlessdynamic.ListOptions
 }
 
 func (m *RootlessDynamicClient) Apply(_ context.Context, obj *unstructured.Unstructured, _ bool) error {
@@ -35,13 +46,21 @@ func (m *RootlessDynamicClient) ApplyMany(_ context.Context, objs []unstructured
 	return m.ReturnErr
 }
 
+func (m *RootlessDynamicClient) Patch(_ context.Context, obj *unstructured.Unstructured, patchType types.PatchType, data []byte, _ bool) (*unstructured.Unstructured, error) {
+	m.PatchObjs = append(m.PatchObjs, *obj)
+	m.PatchTypes = append(m.PatchTypes, patchType)
+	m.PatchDatas = append(m.PatchDatas, data)
+	return &m.ReturnPatchObj, m.ReturnPatchErr
+}
+
 func (m *RootlessDynamicClient) Get(_ context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	m.GetObjs = append(m.GetObjs, *obj)
 	return &m.ReturnGetObj, m.ReturnGetErr
 }
 
-func (m *RootlessDynamicClient) List(_ context.Context, obj *unstructured.Unstructured, _ *rootlessdynamic.ListOptions) (*unstructured.UnstructuredList, error) {
+func (m *RootlessDynamicClient) List(_ context.Context, obj *unstructured.Unstructured, opts *rootlessdynamic.ListOptions) (*unstructured.UnstructuredList, error) {
 	m.ListObjs = append(m.ListObjs, *obj)
+	m.ListOpts = append(m.ListOpts, *opts)
 	return m.ReturnListObjs, m.ReturnErr
 }
 
@@ -55,6 +74,17 @@ func (m *RootlessDynamicClient) RemoveMany(_ context.Context, objs []unstructure
 	return m.ReturnRemoveErr
 }
 
+func (m *RootlessDynamicClient) Watch(_ context.Context, obj *unstructured.Unstructured, opts *rootlessdynamic.ListOptions) (watch.Interface, error) {
+	m.WatchObjs = append(m.WatchObjs, *obj)
+	m.WatchOpts = append(m.WatchOpts, *opts)
+	if len(m.ReturnWatchers) != 0 {
+		watcher := m.ReturnWatchers[0]
+		m.ReturnWatchers = m.ReturnWatchers[1:]
+		return watcher, m.ReturnWatchErr
+	}
+	return m.ReturnWatcher, m.ReturnWatchErr
+}
+
 func (m *RootlessDynamicClient) WatchSingleResource(_ context.Context, obj *unstructured.Unstructured) (watch.Interface, error) {
 	return m.ReturnWatcher, m.ReturnWatchErr
 }
@@ -0,0 +1,173 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/api-gateway/apis/gateway/v2alpha1"
+	"github.com/kyma-project/cli.v3/internal/kube/istio"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+const DefaultAPIRulePath = "/*"
+
+var (
+	DefaultAPIRuleMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH"}
+	// methods supported by the APIRule
+	APIRuleMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "CONNECT", "TRACE"}
+)
+
+type CreateAPIRuleOpts struct {
+	Name      string
+	Namespace string
+	// subdomain of the cluster domain or the fully qualified domain name
+	Host string
+	Port uint32
+	// HTTP methods allowed by every rule, DefaultAPIRuleMethods if empty
+	Methods []string
+	// one rule is created for every path, DefaultAPIRulePath if empty
+	Paths []string
+	// access strategy of rules, rules don't require authentication if JWT and ExtAuthorizers are empty
+	JWT            *APIRuleJWT
+	ExtAuthorizers []string
+	CORS           *APIRuleCORS
+}
+
+type APIRuleJWT struct {
+	Issuer  string
+	JWKSURI string
+}
+
+type APIRuleCORS struct {
+	// allowed origins, the * origin allows all of them
+	AllowOrigins     []string
+	AllowMethods     []string
+	AllowHeaders     []string
+	AllowCredentials bool
+	MaxAge           time.Duration
+}
+
+func CreateAPIRule(ctx context.Context, client rootlessdynamic.Interface, opts CreateAPIRuleOpts) error {
+	apirule, err := APIRuleObject(opts)
+	if err != nil {
+		return err
+	}
+	return client.Apply(ctx, apirule, false)
+}
+
+// APIRuleObject returns the APIRule applied by the CreateAPIRule function
+func APIRuleObject(opts CreateAPIRuleOpts) (*unstructured.Unstructured, error) {
+	return toUnstructured(buildAPIRule(&opts))
+}
+
+// APIRuleURL returns the URL of the APIRule host, subdomains are joined with the cluster domain
+func APIRuleURL(host, domain string) string {
+	if !strings.Contains(host, ".") {
+		host = fmt.Sprintf("%s.%s", host, domain)
+	}
+
+	return fmt.Sprintf("https://%s", host)
+}
+
+func buildAPIRule(opts *CreateAPIRuleOpts) *v2alpha1.APIRule {
+	methods := []v2alpha1.HttpMethod{}
+	for _, method := range valuesOrDefault(opts.Methods, DefaultAPIRuleMethods) {
+		methods = append(methods, v2alpha1.HttpMethod(strings.ToUpper(method)))
+	}
+
+	rules := []v2alpha1.Rule{}
+	for _, path := range valuesOrDefault(opts.Paths, []string{DefaultAPIRulePath}) {
+		rule := v2alpha1.Rule{
+			Path:    path,
+			Methods: methods,
+		}
+
+		switch {
+		case opts.JWT != nil:
+			rule.Jwt = &v2alpha1.JwtConfig{
+				Authentications: []*v2alpha1.JwtAuthentication{
+					{
+						Issuer:  opts.JWT.Issuer,
+						JwksUri: opts.JWT.JWKSURI,
+					},
+				},
+			}
+		case len(opts.ExtAuthorizers) != 0:
+			rule.ExtAuth = &v2alpha1.ExtAuth{
+				ExternalAuthorizers: opts.ExtAuthorizers,
+			}
+		default:
+			rule.NoAuth = ptr.To(true)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &v2alpha1.APIRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: APIRuleAPIVersion,
+			Kind:       APIRuleKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.Name,
+			Namespace: opts.Namespace,
+			Labels:    AppLabels(opts.Name),
+		},
+		Spec: v2alpha1.APIRuleSpec{
+			Hosts: []*v2alpha1.Host{
+				ptr.To(v2alpha1.Host(opts.Host)),
+			},
+			Gateway:    ptr.To(fmt.Sprintf("%s/%s", istio.DefaultGatewayNamespace, istio.DefaultGatewayName)),
+			CorsPolicy: buildCorsPolicy(opts.CORS),
+			Rules:      rules,
+			Service: &v2alpha1.Service{
+				Name:      ptr.To(opts.Name),
+				Namespace: ptr.To(opts.Namespace),
+				Port:      &opts.Port,
+			},
+		},
+	}
+}
+
+func buildCorsPolicy(cors *APIRuleCORS) *v2alpha1.CorsPolicy {
+	if cors == nil {
+		return nil
+	}
+
+	origins := v2alpha1.StringMatch{}
+	for _, origin := range cors.AllowOrigins {
+		if origin == "*" {
+			origins = append(origins, map[string]string{v2alpha1.Regex: ".*"})
+			continue
+		}
+		origins = append(origins, map[string]string{v2alpha1.Exact: origin})
+	}
+
+	policy := &v2alpha1.CorsPolicy{
+		AllowOrigins: origins,
+		AllowMethods: cors.AllowMethods,
+		AllowHeaders: cors.AllowHeaders,
+	}
+	if cors.AllowCredentials {
+		policy.AllowCredentials = ptr.To(true)
+	}
+	if cors.MaxAge != 0 {
+		policy.MaxAge = ptr.To(uint64(cors.MaxAge.Seconds()))
+	}
+
+	return policy
+}
+
+func valuesOrDefault(values, defaultValues []string) []string {
+	if len(values) != 0 {
+		return values
+	}
+
+	return slices.Clone(defaultValues)
+}
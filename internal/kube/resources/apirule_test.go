@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	kube_fake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/istio"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_CreateAPIRule(t *testing.T) {
+	t.Run("create apiRule", func(t *testing.T) {
+		ctx := context.Background()
+		rootlessdynamic := &kube_fake.RootlessDynamicClient{}
+		apiRuleName := "apiRule"
+		namespace := "default"
+		host := "example.com"
+		port := uint32(80)
+
+		err := CreateAPIRule(ctx, rootlessdynamic, CreateAPIRuleOpts{
+			Name:      apiRuleName,
+			Namespace: namespace,
+			Host:      host,
+			Port:      port,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, len(rootlessdynamic.ApplyObjs))
+		require.Equal(t, fixAPIRule(apiRuleName, namespace, host, port), rootlessdynamic.ApplyObjs[0])
+	})
+	t.Run("do not allow creating existing apiRule", func(t *testing.T) {
+		ctx := context.Background()
+		rootlessdynamic := &kube_fake.RootlessDynamicClient{
+			ReturnErr: fmt.Errorf("already exists"),
+		}
+		err := CreateAPIRule(ctx, rootlessdynamic, CreateAPIRuleOpts{
+			Name:      "existing",
+			Namespace: "default",
+			Host:      "example.com",
+			Port:      80,
+		})
+		require.Contains(t, err.Error(), "already exists")
+	})
+}
+
+func Test_buildAPIRule(t *testing.T) {
+	t.Run("rules for many paths with jwt", func(t *testing.T) {
+		apirule := buildAPIRule(&CreateAPIRuleOpts{
+			Name:      "my-app",
+			Namespace: "default",
+			Host:      "api",
+			Port:      8080,
+			Methods:   []string{"get", "POST"},
+			Paths:     []string{"/orders/{**}", "/health"},
+			JWT:       &APIRuleJWT{Issuer: "https://issuer.com", JWKSURI: "https://issuer.com/keys"},
+		})
+
+		require.Len(t, apirule.Spec.Rules, 2)
+		for i, path := range []string{"/orders/{**}", "/health"} {
+			rule := apirule.Spec.Rules[i]
+			require.Equal(t, path, rule.Path)
+			require.Equal(t, "GET", string(rule.Methods[0]))
+			require.Equal(t, "POST", string(rule.Methods[1]))
+			require.Nil(t, rule.NoAuth)
+			require.Nil(t, rule.ExtAuth)
+			require.Equal(t, "https://issuer.com", rule.Jwt.Authentications[0].Issuer)
+			require.Equal(t, "https://issuer.com/keys", rule.Jwt.Authentications[0].JwksUri)
+		}
+		require.Nil(t, apirule.Spec.CorsPolicy)
+	})
+
+	t.Run("ext auth and cors", func(t *testing.T) {
+		apirule := buildAPIRule(&CreateAPIRuleOpts{
+			Name:           "my-app",
+			Namespace:      "default",
+			Host:           "api.example.com",
+			Port:           8080,
+			ExtAuthorizers: []string{"oauth2-proxy"},
+			CORS: &APIRuleCORS{
+				AllowOrigins:     []string{"https://app.example.com", "*"},
+				AllowMethods:     []string{"GET"},
+				AllowHeaders:     []string{"Authorization"},
+				AllowCredentials: true,
+				MaxAge:           10 * time.Minute,
+			},
+		})
+
+		require.Len(t, apirule.Spec.Rules, 1)
+		rule := apirule.Spec.Rules[0]
+		require.Equal(t, DefaultAPIRulePath, rule.Path)
+		require.Len(t, rule.Methods, len(DefaultAPIRuleMethods))
+		require.Nil(t, rule.NoAuth)
+		require.Equal(t, []string{"oauth2-proxy"}, rule.ExtAuth.ExternalAuthorizers)
+
+		cors := apirule.Spec.CorsPolicy
+		require.Equal(t, []map[string]string{{"exact": "https://app.example.com"}, {"regex": ".*"}}, []map[string]string(cors.AllowOrigins))
+		require.Equal(t, []string{"GET"}, cors.AllowMethods)
+		require.Equal(t, []string{"Authorization"}, cors.AllowHeaders)
+		require.True(t, *cors.AllowCredentials)
+		require.Equal(t, uint64(600), *cors.MaxAge)
+	})
+}
+
+func fixAPIRule(apiRuleName, namespace, host string, port uint32) unstructured.Unstructured {
+	return unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "gateway.kyma-project.io/v2alpha1",
+			"kind":       "APIRule",
+			"metadata": map[string]interface{}{
+				"name":      apiRuleName,
+				"namespace": namespace,
+				"labels": map[string]interface{}{
+					"app.kubernetes.io/name":       apiRuleName,
+					"app.kubernetes.io/created-by": "kyma-cli",
+				},
+			},
+			"spec": map[string]interface{}{
+				"hosts": []interface{}{
+					host,
+				},
+				"gateway": fmt.Sprintf("%s/%s", istio.DefaultGatewayNamespace, istio.DefaultGatewayName),
+				"rules": []interface{}{
+					map[string]interface{}{
+						"path":    "/*",
+						"methods": []interface{}{"GET", "POST", "PUT", "DELETE", "PATCH"},
+						"noAuth":  true,
+					},
+				},
+				"service": map[string]interface{}{
+					"name":      apiRuleName,
+					"namespace": namespace,
+					"port":      int64(port),
+				},
+			},
+			"status": map[string]interface{}{"lastProcessedTime": interface{}(nil), "state": ""},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// labels set on all resources created by the app push command
+	AppNameLabel   = "app.kubernetes.io/name"
+	CreatedByLabel = "app.kubernetes.io/created-by"
+	CreatedByValue = "kyma-cli"
+
+	// label used to select pods of the app
+	AppSelectorLabel = "app"
+
+	APIRuleAPIVersion = "gateway.kyma-project.io/v2alpha1"
+	APIRuleKind       = "APIRule"
+)
+
+// AppLabels returns labels set on all resources of the app created by the CLI
+func AppLabels(name string) map[string]string {
+	return map[string]string{
+		AppNameLabel:   name,
+		CreatedByLabel: CreatedByValue,
+	}
+}
+
+// IsCreatedByCLI returns true if the given labels belong to a resource created by the CLI
+func IsCreatedByCLI(labels map[string]string) bool {
+	return labels[CreatedByLabel] == CreatedByValue
+}
+
+// AppAPIRule returns the unstructured APIRule with the given name and namespace to be used by the rootless dynamic client
+func AppAPIRule(name, namespace string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(APIRuleAPIVersion)
+	u.SetKind(APIRuleKind)
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	return u
+}
+
+// RestartDeployment triggers the rollout of the Deployment by updating its pod template annotation the same way as the kubectl does
+func RestartDeployment(ctx context.Context, client kubernetes.Interface, name, namespace string) error {
+	data := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"%s"}}}}}`,
+		time.Now().Format(time.RFC3339))
+
+	_, err := client.AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(data), metav1.PatchOptions{})
+	return err
+}
@@ -11,13 +11,18 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 )
 
 const (
 	SecretMountPathPrefix    = "/bindings/secret-"
 	ConfigmapMountPathPrefix = "/bindings/configmap-"
+
+	DefaultCPURequest    = "50m"
+	DefaultCPULimit      = "300m"
+	DefaultMemoryRequest = "64Mi"
+	DefaultMemoryLimit   = "512Mi"
 )
 
 type CreateDeploymentOpts struct {
@@ -31,15 +36,57 @@ type CreateDeploymentOpts struct {
 	ServiceBindingSecretMounts types.ServiceBindingSecretArray
 	Envs                       []corev1.EnvVar
 	Insecure                   bool
+	// named container ports, probes are sent to the primary HTTP port
+	Ports []types.PortSpec
+	// number of pods, left unset if empty to not override the number of pods managed by the autoscaler
+	Replicas  *int32
+	Resources ContainerResources
+	// paths of the HTTP probes, probes are not configured if empty
+	LivenessProbePath  string
+	ReadinessProbePath string
+}
+
+// ContainerResources contains resource requests and limits of the app container, defaults are used for empty values
+type ContainerResources struct {
+	CPURequest    *resource.Quantity
+	CPULimit      *resource.Quantity
+	MemoryRequest *resource.Quantity
+	MemoryLimit   *resource.Quantity
+}
+
+// ResourceRequirements returns requirements with defaults for empty values
+func (r *ContainerResources) ResourceRequirements() corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: quantityOrDefault(r.MemoryRequest, DefaultMemoryRequest),
+			corev1.ResourceCPU:    quantityOrDefault(r.CPURequest, DefaultCPURequest),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: quantityOrDefault(r.MemoryLimit, DefaultMemoryLimit),
+			corev1.ResourceCPU:    quantityOrDefault(r.CPULimit, DefaultCPULimit),
+		},
+	}
+}
+
+func quantityOrDefault(quantity *resource.Quantity, defaultValue string) resource.Quantity {
+	if quantity != nil {
+		return *quantity
+	}
+
+	return resource.MustParse(defaultValue)
 }
 
 func ApplyDeployment(ctx context.Context, client kube.Client, opts CreateDeploymentOpts) error {
-	deployment := buildDeployment(&opts)
-	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	deployment, err := DeploymentObject(opts)
 	if err != nil {
 		return err
 	}
-	return client.RootlessDynamic().Apply(ctx, &unstructured.Unstructured{Object: unstrObj}, false)
+	return client.RootlessDynamic().Apply(ctx, deployment, false)
+}
+
+// DeploymentObject returns the Deployment applied by the ApplyDeployment function
+func DeploymentObject(opts CreateDeploymentOpts) (*unstructured.Unstructured, error) {
+	return toUnstructured(buildDeployment(&opts))
 }
 
 func buildDeployment(opts *CreateDeploymentOpts) *appsv1.Deployment {
@@ -71,6 +118,15 @@ func buildDeployment(opts *CreateDeploymentOpts) *appsv1.Deployment {
 
 	podSecCtx, secCtx := buildSecurityContext(opts.Insecure)
 
+	containerPorts := []corev1.ContainerPort{}
+	for _, port := range opts.Ports {
+		containerPorts = append(containerPorts, corev1.ContainerPort{
+			Name:          port.Name,
+			ContainerPort: port.Port,
+		})
+	}
+	probePort := PrimaryHTTPPort(opts.Ports)
+
 	// Build environment variables - only add SERVICE_BINDING_ROOT if service binding secrets are mounted
 	envVars := opts.Envs
 	if len(opts.ServiceBindingSecretMounts.Names) > 0 {
@@ -88,12 +144,10 @@ func buildDeployment(opts *CreateDeploymentOpts) *appsv1.Deployment {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      opts.Name,
 			Namespace: opts.Namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       opts.Name,
-				"app.kubernetes.io/created-by": "kyma-cli",
-			},
+			Labels:    AppLabels(opts.Name),
 		},
 		Spec: appsv1.DeploymentSpec{
+			Replicas: opts.Replicas,
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{
 					"app": opts.Name,
@@ -112,26 +166,15 @@ func buildDeployment(opts *CreateDeploymentOpts) *appsv1.Deployment {
 					SecurityContext:              podSecCtx,
 					Containers: []corev1.Container{
 						{
-							Ports: []corev1.ContainerPort{
-								{
-									ContainerPort: 80,
-								},
-							},
+							Ports:           containerPorts,
 							Name:            opts.Name,
 							Image:           opts.Image,
 							Env:             envVars,
 							VolumeMounts:    volumeMounts,
 							SecurityContext: secCtx,
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceMemory: resource.MustParse("64Mi"),
-									corev1.ResourceCPU:    resource.MustParse("50m"),
-								},
-								Limits: corev1.ResourceList{
-									corev1.ResourceMemory: resource.MustParse("512Mi"),
-									corev1.ResourceCPU:    resource.MustParse("300m"),
-								},
-							},
+							Resources:       opts.Resources.ResourceRequirements(),
+							LivenessProbe:   buildHTTPProbe(opts.LivenessProbePath, probePort),
+							ReadinessProbe:  buildHTTPProbe(opts.ReadinessProbePath, probePort),
 						},
 					},
 				},
@@ -153,6 +196,22 @@ func buildDeployment(opts *CreateDeploymentOpts) *appsv1.Deployment {
 	return deployment
 }
 
+// buildHTTPProbe builds the probe sending HTTP GET requests to the given path or returns nil if the path or the port is empty
+func buildHTTPProbe(path string, port *types.PortSpec) *corev1.Probe {
+	if path == "" || port == nil {
+		return nil
+	}
+
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromString(port.Name),
+			},
+		},
+	}
+}
+
 // buildSecretVolumes builds volumes and volume mounts for secrets using the MountArray type
 func buildSecretVolumes(mountArray types.MountArray) ([]corev1.Volume, []corev1.VolumeMount) {
 	volumes := []corev1.Volume{}
@@ -8,7 +8,9 @@ import (
 	kube_fake "github.com/kyma-project/cli.v3/internal/kube/fake"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 )
 
@@ -56,6 +58,65 @@ func Test_BuildDeployment(t *testing.T) {
 		})
 	})
 
+	t.Run("no ports, default resources and no probes", func(t *testing.T) {
+		deployment := buildDeployment(&CreateDeploymentOpts{
+			Name:      "test-app",
+			Namespace: "default",
+			Image:     "test:image",
+		})
+
+		require.Nil(t, deployment.Spec.Replicas)
+		container := deployment.Spec.Template.Spec.Containers[0]
+		require.Empty(t, container.Ports)
+		require.Equal(t, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+				corev1.ResourceCPU:    resource.MustParse("50m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+				corev1.ResourceCPU:    resource.MustParse("300m"),
+			},
+		}, container.Resources)
+		require.Nil(t, container.LivenessProbe)
+		require.Nil(t, container.ReadinessProbe)
+	})
+
+	t.Run("custom ports, replicas, resources and probes", func(t *testing.T) {
+		deployment := buildDeployment(&CreateDeploymentOpts{
+			Name:      "test-app",
+			Namespace: "default",
+			Image:     "test:image",
+			Ports: []types.PortSpec{
+				{Name: "grpc", Port: 9000},
+				{Name: "web", Port: 8080},
+			},
+			Replicas: ptr.To(int32(3)),
+			Resources: ContainerResources{
+				CPURequest:  ptr.To(resource.MustParse("100m")),
+				MemoryLimit: ptr.To(resource.MustParse("1Gi")),
+			},
+			LivenessProbePath:  "/healthz",
+			ReadinessProbePath: "/ready",
+		})
+
+		require.Equal(t, ptr.To(int32(3)), deployment.Spec.Replicas)
+		container := deployment.Spec.Template.Spec.Containers[0]
+		require.Equal(t, []corev1.ContainerPort{
+			{Name: "grpc", ContainerPort: 9000},
+			{Name: "web", ContainerPort: 8080},
+		}, container.Ports)
+		require.Equal(t, resource.MustParse("100m"), container.Resources.Requests[corev1.ResourceCPU])
+		require.Equal(t, resource.MustParse("64Mi"), container.Resources.Requests[corev1.ResourceMemory])
+		require.Equal(t, resource.MustParse("300m"), container.Resources.Limits[corev1.ResourceCPU])
+		require.Equal(t, resource.MustParse("1Gi"), container.Resources.Limits[corev1.ResourceMemory])
+		// probes are sent to the first http port
+		require.Equal(t, &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("web")}, container.LivenessProbe.HTTPGet)
+		require.Equal(t, &corev1.HTTPGetAction{Path: "/ready", Port: intstr.FromString("web")}, container.ReadinessProbe.HTTPGet)
+		// probes don't weaken the default security context
+		require.Equal(t, ptr.To(true), container.SecurityContext.RunAsNonRoot)
+	})
+
 	t.Run("security context", func(t *testing.T) {
 		t.Run("secure mode sets expected pod and container security context", func(t *testing.T) {
 			deployment := buildDeployment(&CreateDeploymentOpts{
@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/istio"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var gatewayGVR = schema.GroupVersionResource{
+	Group:    "networking.istio.io",
+	Version:  "v1",
+	Resource: "gateways",
+}
+
+// GetClusterDomain returns the domain of the cluster based on the wildcard host of the kyma-gateway Gateway
+// or the domain from the shoot-info ConfigMap if the Gateway can't be read
+func GetClusterDomain(ctx context.Context, client kube.Client) (string, error) {
+	gateway, gatewayErr := client.Dynamic().Resource(gatewayGVR).
+		Namespace(istio.DefaultGatewayNamespace).
+		Get(ctx, istio.DefaultGatewayName, metav1.GetOptions{})
+	if gatewayErr == nil {
+		domain := gatewayDomain(gateway)
+		if domain != "" {
+			return domain, nil
+		}
+		gatewayErr = errors.New("the kyma-gateway Gateway has no wildcard host")
+	}
+
+	shootInfo, shootInfoErr := client.Static().CoreV1().ConfigMaps("kube-system").Get(ctx, "shoot-info", metav1.GetOptions{})
+	if shootInfoErr == nil {
+		domain := shootInfo.Data["domain"]
+		if domain != "" {
+			return domain, nil
+		}
+		shootInfoErr = errors.New("the shoot-info ConfigMap has no domain")
+	}
+
+	return "", errors.Join(gatewayErr, shootInfoErr)
+}
+
+// gatewayDomain returns the domain from the first wildcard host of the Gateway servers
+func gatewayDomain(gateway *unstructured.Unstructured) string {
+	servers, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "servers")
+	for _, server := range servers {
+		serverMap, ok := server.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		hosts, _, _ := unstructured.NestedStringSlice(serverMap, "hosts")
+		for _, host := range hosts {
+			// hosts may be prefixed with the namespace in the namespace/host format
+			if index := strings.LastIndex(host, "/"); index != -1 {
+				host = host[index+1:]
+			}
+
+			if domain, found := strings.CutPrefix(host, "*."); found {
+				return domain
+			}
+		}
+	}
+
+	return ""
+}
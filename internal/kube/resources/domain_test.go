@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	kube_fake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamic_fake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_GetClusterDomain(t *testing.T) {
+	shootInfo := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "shoot-info", Namespace: "kube-system"},
+		Data:       map[string]string{"domain": "shoot.example.com"},
+	}
+
+	t.Run("domain from the gateway", func(t *testing.T) {
+		client := fixDomainKubeClient(t, fixGateway("kyma-system/*.gateway.example.com"), shootInfo)
+
+		domain, err := GetClusterDomain(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, "gateway.example.com", domain)
+	})
+
+	t.Run("domain from the shoot-info when gateway has no wildcard host", func(t *testing.T) {
+		client := fixDomainKubeClient(t, fixGateway("app.example.com"), shootInfo)
+
+		domain, err := GetClusterDomain(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, "shoot.example.com", domain)
+	})
+
+	t.Run("domain from the shoot-info when gateway is missing", func(t *testing.T) {
+		client := fixDomainKubeClient(t, nil, shootInfo)
+
+		domain, err := GetClusterDomain(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, "shoot.example.com", domain)
+	})
+
+	t.Run("domain not found", func(t *testing.T) {
+		client := fixDomainKubeClient(t, fixGateway("app.example.com"), nil)
+
+		domain, err := GetClusterDomain(context.Background(), client)
+		require.ErrorContains(t, err, "the kyma-gateway Gateway has no wildcard host")
+		require.ErrorContains(t, err, "configmaps \"shoot-info\" not found")
+		require.Empty(t, domain)
+	})
+}
+
+func Test_APIRuleURL(t *testing.T) {
+	require.Equal(t, "https://my-app.example.com", APIRuleURL("my-app", "example.com"))
+	require.Equal(t, "https://app.custom.com", APIRuleURL("app.custom.com", "example.com"))
+}
+
+func fixDomainKubeClient(t *testing.T, gateway *unstructured.Unstructured, shootInfo *corev1.ConfigMap) *kube_fake.KubeClient {
+	dynamicClient := dynamic_fake.NewSimpleDynamicClient(runtime.NewScheme())
+	if gateway != nil {
+		// add the gateway with its GVR because the fake client can't guess the resource name of the Gateway kind
+		require.NoError(t, dynamicClient.Tracker().Create(gatewayGVR, gateway, gateway.GetNamespace()))
+	}
+	staticObjs := []runtime.Object{}
+	if shootInfo != nil {
+		staticObjs = append(staticObjs, shootInfo)
+	}
+
+	return &kube_fake.KubeClient{
+		TestKubernetesInterface: k8sfake.NewClientset(staticObjs...),
+		TestDynamicInterface:    dynamicClient,
+	}
+}
+
+func fixGateway(hosts ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "networking.istio.io/v1",
+			"kind":       "Gateway",
+			"metadata": map[string]interface{}{
+				"name":      "kyma-gateway",
+				"namespace": "kyma-system",
+			},
+			"spec": map[string]interface{}{
+				"servers": []interface{}{
+					map[string]interface{}{
+						"hosts": hosts,
+					},
+				},
+			},
+		},
+	}
+}
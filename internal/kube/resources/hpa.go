@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type CreateHPAOpts struct {
+	Name        string
+	Namespace   string
+	MinReplicas int32
+	MaxReplicas int32
+	// target average CPU utilization of pods in percents of the CPU request
+	CPUUtilization int32
+}
+
+// ApplyHPA applies the HorizontalPodAutoscaler scaling the app Deployment based on the CPU utilization
+func ApplyHPA(ctx context.Context, client rootlessdynamic.Interface, opts CreateHPAOpts) error {
+	hpa, err := HPAObject(opts)
+	if err != nil {
+		return err
+	}
+	return client.Apply(ctx, hpa, false)
+}
+
+// HPAObject returns the HorizontalPodAutoscaler applied by the ApplyHPA function
+func HPAObject(opts CreateHPAOpts) (*unstructured.Unstructured, error) {
+	return toUnstructured(buildHPA(&opts))
+}
+
+func buildHPA(opts *CreateHPAOpts) *autoscalingv2.HorizontalPodAutoscaler {
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "autoscaling/v2",
+			Kind:       "HorizontalPodAutoscaler",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.Name,
+			Namespace: opts.Namespace,
+			Labels:    AppLabels(opts.Name),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       opts.Name,
+			},
+			MinReplicas: &opts.MinReplicas,
+			MaxReplicas: opts.MaxReplicas,
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name: corev1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{
+							Type:               autoscalingv2.UtilizationMetricType,
+							AverageUtilization: &opts.CPUUtilization,
+						},
+					},
+				},
+			},
+		},
+	}
+}
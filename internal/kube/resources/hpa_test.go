@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	kube_fake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_ApplyHPA(t *testing.T) {
+	t.Parallel()
+	rdClient := &kube_fake.RootlessDynamicClient{}
+
+	err := ApplyHPA(context.Background(), rdClient, CreateHPAOpts{
+		Name:           "test-app",
+		Namespace:      "default",
+		MinReplicas:    2,
+		MaxReplicas:    5,
+		CPUUtilization: 70,
+	})
+	require.NoError(t, err)
+	require.Len(t, rdClient.ApplyObjs, 1)
+
+	hpa := rdClient.ApplyObjs[0]
+	require.Equal(t, "autoscaling/v2", hpa.GetAPIVersion())
+	require.Equal(t, "HorizontalPodAutoscaler", hpa.GetKind())
+	require.Equal(t, AppLabels("test-app"), hpa.GetLabels())
+
+	target, _, _ := unstructured.NestedStringMap(hpa.Object, "spec", "scaleTargetRef")
+	require.Equal(t, map[string]string{"apiVersion": "apps/v1", "kind": "Deployment", "name": "test-app"}, target)
+	minReplicas, _, _ := unstructured.NestedInt64(hpa.Object, "spec", "minReplicas")
+	require.Equal(t, int64(2), minReplicas)
+	maxReplicas, _, _ := unstructured.NestedInt64(hpa.Object, "spec", "maxReplicas")
+	require.Equal(t, int64(5), maxReplicas)
+	metrics, _, _ := unstructured.NestedSlice(hpa.Object, "spec", "metrics")
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"type": "Resource",
+			"resource": map[string]interface{}{
+				"name": "cpu",
+				"target": map[string]interface{}{
+					"type":               "Utilization",
+					"averageUtilization": int64(70),
+				},
+			},
+		},
+	}, metrics)
+}
@@ -3,46 +3,87 @@ package resources
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
-	"github.com/kyma-project/api-gateway/apis/gateway/v2alpha1"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 	"github.com/kyma-project/cli.v3/internal/kube"
-	"github.com/kyma-project/cli.v3/internal/kube/istio"
-	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/utils/ptr"
 )
 
-func ApplyService(ctx context.Context, client kube.Client, name, namespace string, port int32) error {
-	service := buildService(name, namespace, port)
-	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(service)
-	if err != nil {
-		return err
+// protocols recognized by istio in port name prefixes, longer names go first to match them before their prefixes
+var istioProtocols = []string{"grpc-web", "grpc", "http2", "https", "http", "tcp", "tls", "udp", "mongo", "mysql", "redis"}
+
+// PortProtocol returns the istio protocol derived from the port name, http is used for names without a known protocol
+func PortProtocol(name string) string {
+	for _, protocol := range istioProtocols {
+		if name == protocol || strings.HasPrefix(name, protocol+"-") {
+			return protocol
+		}
 	}
-	return client.RootlessDynamic().Apply(ctx, &unstructured.Unstructured{Object: unstrObj}, false)
+
+	return "http"
 }
 
-func CreateAPIRule(ctx context.Context, client rootlessdynamic.Interface, name, namespace, host string, port uint32) error {
-	apirule := buildAPIRule(name, namespace, host, port)
-	uAPIRule, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&apirule)
+// PrimaryHTTPPort returns the port named http or the first port using the plain http protocol
+// returns nil if there is no such port
+func PrimaryHTTPPort(ports []types.PortSpec) *types.PortSpec {
+	for i := range ports {
+		if ports[i].Name == "http" {
+			return &ports[i]
+		}
+	}
+
+	for i := range ports {
+		if slices.Contains([]string{"http", "http2"}, PortProtocol(ports[i].Name)) {
+			return &ports[i]
+		}
+	}
+
+	return nil
+}
+
+// servicePortName returns the port name prefixed with its protocol to follow the istio port naming convention
+func servicePortName(name string) string {
+	protocol := PortProtocol(name)
+	if name == protocol || strings.HasPrefix(name, protocol+"-") {
+		return name
+	}
+
+	return fmt.Sprintf("%s-%s", protocol, name)
+}
+
+func ApplyService(ctx context.Context, client kube.Client, name, namespace string, ports []types.PortSpec) error {
+	service, err := ServiceObject(name, namespace, ports)
 	if err != nil {
 		return err
 	}
-	return client.Apply(ctx, &unstructured.Unstructured{Object: uAPIRule}, false)
+	return client.RootlessDynamic().Apply(ctx, service, false)
+}
+
+// ServiceObject returns the Service applied by the ApplyService function
+func ServiceObject(name, namespace string, ports []types.PortSpec) (*unstructured.Unstructured, error) {
+	return toUnstructured(buildService(name, namespace, ports))
 }
 
-func buildService(name, namespace string, port int32) *corev1.Service {
+func buildService(name, namespace string, ports []types.PortSpec) *corev1.Service {
+	servicePorts := []corev1.ServicePort{}
+	for _, port := range ports {
+		servicePorts = append(servicePorts, corev1.ServicePort{
+			Name:       servicePortName(port.Name),
+			Port:       port.Port,
+			TargetPort: intstr.FromInt32(port.Port),
+		})
+	}
+
 	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       name,
-				"app.kubernetes.io/created-by": "kyma-cli",
-			},
+			Labels:    AppLabels(name),
 		},
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
@@ -52,47 +93,7 @@ func buildService(name, namespace string, port int32) *corev1.Service {
 			Selector: map[string]string{
 				"app": name,
 			},
-			Ports: []corev1.ServicePort{
-				{
-					Port:       port,
-					TargetPort: intstr.FromInt32(port),
-				},
-			},
-		},
-	}
-}
-
-func buildAPIRule(name, namespace, host string, port uint32) *v2alpha1.APIRule {
-	return &v2alpha1.APIRule{
-		TypeMeta: metav1.TypeMeta{
-			APIVersion: "gateway.kyma-project.io/v2alpha1",
-			Kind:       "APIRule",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       name,
-				"app.kubernetes.io/created-by": "kyma-cli",
-			},
-		},
-		Spec: v2alpha1.APIRuleSpec{
-			Hosts: []*v2alpha1.Host{
-				ptr.To(v2alpha1.Host(host)),
-			},
-			Gateway: ptr.To(fmt.Sprintf("%s/%s", istio.DefaultGatewayNamespace, istio.DefaultGatewayName)),
-			Rules: []v2alpha1.Rule{
-				{
-					Path:    "/*",
-					Methods: []v2alpha1.HttpMethod{"GET", "POST", "PUT", "DELETE", "PATCH"},
-					NoAuth:  ptr.To(true),
-				},
-			},
-			Service: &v2alpha1.Service{
-				Name:      ptr.To(name),
-				Namespace: ptr.To(namespace),
-				Port:      &port,
-			},
+			Ports: servicePorts,
 		},
 	}
 }
@@ -2,80 +2,16 @@ package resources
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 	kube_fake "github.com/kyma-project/cli.v3/internal/kube/fake"
-	"github.com/kyma-project/cli.v3/internal/kube/istio"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func Test_CreateAPIRule(t *testing.T) {
-	t.Run("create apiRule", func(t *testing.T) {
-		ctx := context.Background()
-		rootlessdynamic := &kube_fake.RootlessDynamicClient{}
-		apiRuleName := "apiRule"
-		namespace := "default"
-		host := "example.com"
-		port := uint32(80)
-
-		err := CreateAPIRule(ctx, rootlessdynamic, apiRuleName, namespace, host, port)
-
-		require.NoError(t, err)
-		require.Equal(t, 1, len(rootlessdynamic.ApplyObjs))
-		require.Equal(t, fixAPIRule(apiRuleName, namespace, host, port), rootlessdynamic.ApplyObjs[0])
-	})
-	t.Run("do not allow creating existing apiRule", func(t *testing.T) {
-		ctx := context.Background()
-		rootlessdynamic := &kube_fake.RootlessDynamicClient{
-			ReturnErr: fmt.Errorf("already exists"),
-		}
-		apiRuleName := "existing"
-		namespace := "default"
-		domain := "example.com"
-		port := uint32(80)
-		err := CreateAPIRule(ctx, rootlessdynamic, apiRuleName, namespace, domain, port)
-		require.Contains(t, err.Error(), "already exists")
-	})
-}
-
-func fixAPIRule(apiRuleName, namespace, host string, port uint32) unstructured.Unstructured {
-	return unstructured.Unstructured{
-		Object: map[string]interface{}{
-			"apiVersion": "gateway.kyma-project.io/v2alpha1",
-			"kind":       "APIRule",
-			"metadata": map[string]interface{}{
-				"name":      apiRuleName,
-				"namespace": namespace,
-				"labels": map[string]interface{}{
-					"app.kubernetes.io/name":       apiRuleName,
-					"app.kubernetes.io/created-by": "kyma-cli",
-				},
-			},
-			"spec": map[string]interface{}{
-				"hosts": []interface{}{
-					host,
-				},
-				"gateway": fmt.Sprintf("%s/%s", istio.DefaultGatewayNamespace, istio.DefaultGatewayName),
-				"rules": []interface{}{
-					map[string]interface{}{
-						"path":    "/*",
-						"methods": []interface{}{"GET", "POST", "PUT", "DELETE", "PATCH"},
-						"noAuth":  true,
-					},
-				},
-				"service": map[string]interface{}{
-					"name":      apiRuleName,
-					"namespace": namespace,
-					"port":      int64(port),
-				},
-			},
-			"status": map[string]interface{}{"lastProcessedTime": interface{}(nil), "state": ""},
-		},
-	}
-}
-
 func Test_ApplyService(t *testing.T) {
 	t.Parallel()
 	t.Run("apply creates service when it does not exist", func(t *testing.T) {
@@ -84,7 +20,7 @@ func Test_ApplyService(t *testing.T) {
 			TestRootlessDynamicInterface: rdClient,
 		}
 
-		err := ApplyService(context.Background(), kubeClient, "test-svc", "default", 8080)
+		err := ApplyService(context.Background(), kubeClient, "test-svc", "default", []types.PortSpec{{Name: "http", Port: 8080}})
 		require.NoError(t, err)
 		require.Len(t, rdClient.ApplyObjs, 1)
 		require.Equal(t, "v1", rdClient.ApplyObjs[0].GetAPIVersion())
@@ -100,11 +36,11 @@ func Test_ApplyService(t *testing.T) {
 		}
 
 		// First apply
-		err := ApplyService(context.Background(), kubeClient, "test-svc", "default", 8080)
+		err := ApplyService(context.Background(), kubeClient, "test-svc", "default", []types.PortSpec{{Name: "http", Port: 8080}})
 		require.NoError(t, err)
 
 		// Second apply with different port — no error
-		err = ApplyService(context.Background(), kubeClient, "test-svc", "default", 9090)
+		err = ApplyService(context.Background(), kubeClient, "test-svc", "default", []types.PortSpec{{Name: "http", Port: 9090}})
 		require.NoError(t, err)
 		require.Len(t, rdClient.ApplyObjs, 2)
 
@@ -115,3 +51,42 @@ func Test_ApplyService(t *testing.T) {
 		require.Equal(t, int64(9090), port["port"])
 	})
 }
+
+func Test_buildService(t *testing.T) {
+	service := buildService("test-svc", "default", []types.PortSpec{
+		{Name: "http", Port: 8080},
+		{Name: "metrics", Port: 9090},
+		{Name: "grpc-api", Port: 9000},
+		{Name: "tcp", Port: 5432},
+	})
+
+	require.Equal(t, []corev1.ServicePort{
+		{Name: "http", Port: 8080, TargetPort: intstr.FromInt32(8080)},
+		{Name: "http-metrics", Port: 9090, TargetPort: intstr.FromInt32(9090)},
+		{Name: "grpc-api", Port: 9000, TargetPort: intstr.FromInt32(9000)},
+		{Name: "tcp", Port: 5432, TargetPort: intstr.FromInt32(5432)},
+	}, service.Spec.Ports)
+}
+
+func Test_PortProtocol(t *testing.T) {
+	require.Equal(t, "http", PortProtocol("http"))
+	require.Equal(t, "http", PortProtocol("metrics"))
+	require.Equal(t, "http2", PortProtocol("http2-api"))
+	require.Equal(t, "grpc", PortProtocol("grpc"))
+	require.Equal(t, "grpc-web", PortProtocol("grpc-web"))
+	require.Equal(t, "tcp", PortProtocol("tcp-db"))
+	require.Equal(t, "http", PortProtocol("grpcapi"))
+}
+
+func Test_PrimaryHTTPPort(t *testing.T) {
+	require.Equal(t, &types.PortSpec{Name: "http", Port: 8080}, PrimaryHTTPPort([]types.PortSpec{
+		{Name: "metrics", Port: 9090},
+		{Name: "http", Port: 8080},
+	}))
+	require.Equal(t, &types.PortSpec{Name: "web", Port: 8080}, PrimaryHTTPPort([]types.PortSpec{
+		{Name: "grpc", Port: 9000},
+		{Name: "web", Port: 8080},
+	}))
+	require.Nil(t, PrimaryHTTPPort([]types.PortSpec{{Name: "grpc", Port: 9000}}))
+	require.Nil(t, PrimaryHTTPPort(nil))
+}
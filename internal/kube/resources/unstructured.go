@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // ReadFromFiles reads and decodes objects from given paths
@@ -58,3 +59,13 @@ func DecodeYaml(r io.Reader) ([]unstructured.Unstructured, error) {
 
 	return results, nil
 }
+
+// toUnstructured converts the typed object to the unstructured one
+func toUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: unstrObj}, nil
+}
@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -20,8 +21,10 @@ type Interface interface {
 	List(context.Context, *unstructured.Unstructured, *ListOptions) (*unstructured.UnstructuredList, error)
 	Apply(context.Context, *unstructured.Unstructured, bool) error
 	ApplyMany(context.Context, []unstructured.Unstructured) error
+	Patch(context.Context, *unstructured.Unstructured, types.PatchType, []byte, bool) (*unstructured.Unstructured, error)
 	Remove(context.Context, *unstructured.Unstructured, bool) error
 	RemoveMany(context.Context, []unstructured.Unstructured) error
+	Watch(context.Context, *unstructured.Unstructured, *ListOptions) (watch.Interface, error)
 	WatchSingleResource(context.Context, *unstructured.Unstructured) (watch.Interface, error)
 }
 
@@ -67,6 +70,9 @@ func (c *client) Get(ctx context.Context, resource *unstructured.Unstructured) (
 type ListOptions struct {
 	AllNamespaces bool
 	FieldSelector string
+	LabelSelector string
+	// resource version to start watching from, used by the Watch func only
+	ResourceVersion string
 }
 
 func (c *client) List(ctx context.Context, resource *unstructured.Unstructured, opts *ListOptions) (*unstructured.UnstructuredList, error) {
@@ -85,14 +91,43 @@ func (c *client) List(ctx context.Context, resource *unstructured.Unstructured,
 	if apiResource.Namespaced && !opts.AllNamespaces && resource.GetNamespace() != "" {
 		return c.dynamic.Resource(*gvr).Namespace(getResourceNamespace(resource)).List(ctx, metav1.ListOptions{
 			FieldSelector: opts.FieldSelector,
+			LabelSelector: opts.LabelSelector,
 		})
 	}
 
 	return c.dynamic.Resource(*gvr).List(ctx, metav1.ListOptions{
 		FieldSelector: opts.FieldSelector,
+		LabelSelector: opts.LabelSelector,
 	})
 }
 
+// Watch watches resources of the given kind the same way the List func lists them
+func (c *client) Watch(ctx context.Context, resource *unstructured.Unstructured, opts *ListOptions) (watch.Interface, error) {
+	group, version := groupVersion(resource.GetAPIVersion())
+	apiResource, err := c.discoverAPIResource(group, version, resource.GetKind())
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover API resource using discovery client: %w", err)
+	}
+
+	gvr := &schema.GroupVersionResource{
+		Group:    group,
+		Version:  version,
+		Resource: apiResource.Name,
+	}
+
+	listOpts := metav1.ListOptions{
+		FieldSelector:   opts.FieldSelector,
+		LabelSelector:   opts.LabelSelector,
+		ResourceVersion: opts.ResourceVersion,
+	}
+
+	if apiResource.Namespaced && !opts.AllNamespaces && resource.GetNamespace() != "" {
+		return c.dynamic.Resource(*gvr).Namespace(getResourceNamespace(resource)).Watch(ctx, listOpts)
+	}
+
+	return c.dynamic.Resource(*gvr).Watch(ctx, listOpts)
+}
+
 func (c *client) Apply(ctx context.Context, resource *unstructured.Unstructured, druRun bool) error {
 	group, version := groupVersion(resource.GetAPIVersion())
 	apiResource, err := c.discoverAPIResource(group, version, resource.GetKind())
@@ -131,6 +166,47 @@ func (c *client) ApplyMany(ctx context.Context, objs []unstructured.Unstructured
 	return nil
 }
 
+// Patch patches the resource identified by the apiVersion, kind, name and namespace of the given object
+// the ApplyPatchType runs server-side apply with the same field manager as the Apply func
+func (c *client) Patch(ctx context.Context, resource *unstructured.Unstructured, patchType types.PatchType, data []byte, dryRun bool) (*unstructured.Unstructured, error) {
+	group, version := groupVersion(resource.GetAPIVersion())
+	apiResource, err := c.discoverAPIResource(group, version, resource.GetKind())
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover API resource using discovery client: %w", err)
+	}
+
+	gvr := &schema.GroupVersionResource{
+		Group:    group,
+		Version:  version,
+		Resource: apiResource.Name,
+	}
+
+	opts := metav1.PatchOptions{
+		FieldManager: "cli",
+	}
+	if dryRun {
+		opts.DryRun = []string{"All"}
+	}
+	if patchType == types.ApplyPatchType {
+		force := true
+		opts.Force = &force
+	}
+
+	if apiResource.Namespaced {
+		patched, err := c.dynamic.Resource(*gvr).Namespace(getResourceNamespace(resource)).Patch(ctx, resource.GetName(), patchType, data, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to patch namespaced resource: %w", err)
+		}
+		return patched, nil
+	}
+
+	patched, err := c.dynamic.Resource(*gvr).Patch(ctx, resource.GetName(), patchType, data, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch cluster-scoped resource: %w", err)
+	}
+	return patched, nil
+}
+
 func (c *client) Remove(ctx context.Context, resource *unstructured.Unstructured, dryRun bool) error {
 	group, version := groupVersion(resource.GetAPIVersion())
 	apiResource, err := c.discoverAPIResource(group, version, resource.GetKind())
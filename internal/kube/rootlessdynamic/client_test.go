@@ -9,6 +9,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	clientgo_fake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynamic_fake "k8s.io/client-go/dynamic/fake"
@@ -216,6 +218,54 @@ func Test_Get(t *testing.T) {
 	})
 }
 
+func Test_Patch(t *testing.T) {
+	t.Run("merge patch namespaced resource", func(t *testing.T) {
+		obj, apiResource := fixSecretObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme, obj)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{apiResource})
+
+		result, err := client.Patch(ctx, obj, types.MergePatchType, []byte(`{"data":{"key":"value2"}}`), false)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{"key": "value2"}, result.Object["data"])
+	})
+
+	t.Run("merge patch cluster-scoped resource", func(t *testing.T) {
+		obj, apiResource := fixClusterRoleObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme, obj)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{apiResource})
+
+		result, err := client.Patch(ctx, obj, types.MergePatchType, []byte(`{"metadata":{"labels":{"app":"test"}}}`), false)
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"app": "test"}, result.GetLabels())
+	})
+
+	t.Run("patch missing resource", func(t *testing.T) {
+		obj, apiResource := fixSecretObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{apiResource})
+
+		_, err := client.Patch(ctx, obj, types.MergePatchType, []byte(`{}`), false)
+		require.ErrorContains(t, err, "failed to patch namespaced resource")
+	})
+
+	t.Run("patch resource error because can't be discovered", func(t *testing.T) {
+		obj, _ := fixSecretObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+			},
+		})
+
+		_, err := client.Patch(ctx, obj, types.MergePatchType, []byte(`{}`), false)
+		require.ErrorContains(t, err, "failed to discover API resource using discovery client: resource 'Secret' in group '', and version 'v1' not registered on cluster")
+	})
+}
+
 var (
 	secretListObject = map[string]interface{}{
 		"apiVersion": "v1",
@@ -312,6 +362,43 @@ func Test_List(t *testing.T) {
 	})
 }
 
+func Test_Watch(t *testing.T) {
+	t.Run("watch namespaced resources", func(t *testing.T) {
+		obj, apiResource := fixSecretObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{apiResource})
+
+		watcher, err := client.Watch(ctx, obj, &ListOptions{
+			LabelSelector: "app=test",
+		})
+		require.NoError(t, err)
+		defer watcher.Stop()
+
+		gvr := schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+		_, err = dynamic.Resource(gvr).Namespace("kyma-system").Create(ctx, obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+
+		event := <-watcher.ResultChan()
+		require.Equal(t, watch.Added, event.Type)
+		require.Equal(t, obj, event.Object)
+	})
+
+	t.Run("watch resource error because can't be discovered", func(t *testing.T) {
+		obj, _ := fixSecretObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+			},
+		})
+
+		_, err := client.Watch(ctx, obj, &ListOptions{})
+		require.ErrorContains(t, err, "failed to discover API resource using discovery client: resource 'Secret' in group '', and version 'v1' not registered on cluster")
+	})
+}
+
 func Test_Remove(t *testing.T) {
 	t.Run("remove namespaced resource", func(t *testing.T) {
 		obj, apiResource := fixSecretObjectAndApiResource()
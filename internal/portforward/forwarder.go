@@ -0,0 +1,295 @@
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/rest"
+)
+
+// PodResolver returns the name of the pod that receives forwarded connections
+type PodResolver func(ctx context.Context) (string, error)
+
+// Forwarder forwards local connections to the port of the pod returned by the resolver
+// the pod is resolved for every connection, so new connections reach new pods after the app is rolled out
+// resolving and dialing the pod is retried to reconnect while pods restart
+type Forwarder struct {
+	remotePort string
+	resolvePod PodResolver
+	dial       func(podName string) (httpstream.Connection, error)
+	// delays between retries of connecting to the pod
+	backoff []time.Duration
+
+	lock sync.Mutex
+	// connection to the currently resolved pod
+	current *podConnection
+	// connections to previous pods closed when their last stream is done
+	retired map[*podConnection]struct{}
+}
+
+// podConnection counts streams using the connection to close it only when no forwarded connection uses it
+type podConnection struct {
+	conn    httpstream.Connection
+	podName string
+	streams int
+}
+
+// NewForwarder creates the Forwarder for the pod port in the given namespace
+func NewForwarder(config *rest.Config, namespace string, remotePort int32, resolvePod PodResolver) *Forwarder {
+	return &Forwarder{
+		remotePort: strconv.Itoa(int(remotePort)),
+		resolvePod: resolvePod,
+		dial: func(podName string) (httpstream.Connection, error) {
+			return NewDialFor(config, podName, namespace)
+		},
+		backoff: backoffSchedule,
+		retired: map[*podConnection]struct{}{},
+	}
+}
+
+// Serve forwards connections accepted by the listener until the context is done
+// failures of single connections are passed to the onError function and don't stop the forwarder
+func (f *Forwarder) Serve(ctx context.Context, listener net.Listener, onError func(error)) error {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			listener.Close()
+		case <-stop:
+		}
+	}()
+	defer f.closeConnections()
+
+	for {
+		localConn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+
+		go func() {
+			err := f.forward(ctx, localConn)
+			if err != nil && onError != nil {
+				onError(err)
+			}
+		}()
+	}
+}
+
+// forward copies data between the local connection and the pod port
+// the logic is mostly based on the k8s.io/client-go/tools/portforward package
+func (f *Forwarder) forward(ctx context.Context, localConn net.Conn) error {
+	defer localConn.Close()
+
+	streams, err := f.openStreams(ctx)
+	if err != nil {
+		return err
+	}
+	defer f.release(streams.conn)
+	defer streams.conn.conn.RemoveStreams(streams.errorStream, streams.dataStream)
+
+	errorChan := make(chan error)
+	go handleErrorStream(streams.errorStream, errorChan)
+
+	localDone := make(chan struct{})
+	remoteDone := make(chan struct{})
+
+	go func() {
+		// copy from the remote side to the local connection
+		_, _ = io.Copy(localConn, streams.dataStream)
+		close(remoteDone)
+	}()
+
+	go func() {
+		// inform the remote server that there is nothing more to receive
+		defer streams.dataStream.Close()
+
+		// copy from the local connection to the remote side
+		_, _ = io.Copy(streams.dataStream, localConn)
+		close(localDone)
+	}()
+
+	// wait until one of the sides closes the connection
+	select {
+	case <-remoteDone:
+	case <-localDone:
+	}
+
+	// always expect something on errorChan (it may be nil)
+	err = <-errorChan
+	if err != nil {
+		return fmt.Errorf("failed to forward connection to port %s of the %s pod: %w", f.remotePort, streams.conn.podName, err)
+	}
+
+	return nil
+}
+
+// podStreams are streams of one forwarded connection
+type podStreams struct {
+	conn        *podConnection
+	errorStream httpstream.Stream
+	dataStream  httpstream.Stream
+}
+
+// openStreams creates streams to the resolved pod and retries on error to reconnect while pods restart
+func (f *Forwarder) openStreams(ctx context.Context) (*podStreams, error) {
+	var errList []error
+	for i := 0; ; i++ {
+		streams, err := f.tryOpenStreams(ctx)
+		if err == nil {
+			return streams, nil
+		}
+
+		errList = append(errList, err)
+		if i >= len(f.backoff) {
+			return nil, errors.Join(errList...)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Join(errList...)
+		case <-time.After(f.backoff[i]):
+		}
+	}
+}
+
+func (f *Forwarder) tryOpenStreams(ctx context.Context) (*podStreams, error) {
+	conn, err := f.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	forwardID := rand.Int()
+
+	// create error stream
+	errorStream, err := createStream(conn.conn, f.remotePort, v1.StreamTypeError, forwardID)
+	if err != nil {
+		// the connection is probably broken, dial a new one on retry
+		f.resetConnection(conn)
+		return nil, fmt.Errorf("error creating error stream for port %s of the %s pod: %v", f.remotePort, conn.podName, err)
+	}
+	// close stream to inform remote server that we are not going to send any data,
+	// and that we are ready to receive the errors
+	errorStream.Close()
+
+	// create data stream
+	dataStream, err := createStream(conn.conn, f.remotePort, v1.StreamTypeData, forwardID)
+	if err != nil {
+		conn.conn.RemoveStreams(errorStream)
+		f.resetConnection(conn)
+		return nil, fmt.Errorf("error creating data stream for port %s of the %s pod: %v", f.remotePort, conn.podName, err)
+	}
+
+	return &podStreams{
+		conn:        conn,
+		errorStream: errorStream,
+		dataStream:  dataStream,
+	}, nil
+}
+
+// connect returns the open connection to the resolved pod and dials a new one if the pod has changed
+// the returned connection is in use until it's released
+func (f *Forwarder) connect(ctx context.Context) (*podConnection, error) {
+	podName, err := f.resolvePod(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.current != nil && f.current.podName == podName && !isClosed(f.current.conn) {
+		f.current.streams++
+		return f.current, nil
+	}
+
+	if f.current != nil {
+		// don't break connections still forwarded to the previous pod
+		f.retire(f.current)
+		f.current = nil
+	}
+
+	conn, err := f.dial(podName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the %s pod: %w", podName, err)
+	}
+
+	f.current = &podConnection{
+		conn:    conn,
+		podName: podName,
+		streams: 1,
+	}
+	return f.current, nil
+}
+
+// release marks the end of the forwarded connection and closes the retired connection if it's no longer used
+func (f *Forwarder) release(conn *podConnection) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	conn.streams--
+	if _, ok := f.retired[conn]; ok && conn.streams <= 0 {
+		delete(f.retired, conn)
+		conn.conn.Close()
+	}
+}
+
+// retire closes the connection when its last stream is done, must be called with the lock held
+func (f *Forwarder) retire(conn *podConnection) {
+	if conn.streams <= 0 {
+		conn.conn.Close()
+		return
+	}
+
+	f.retired[conn] = struct{}{}
+}
+
+// resetConnection closes the broken connection and releases it
+func (f *Forwarder) resetConnection(conn *podConnection) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	conn.streams--
+	conn.conn.Close()
+	delete(f.retired, conn)
+	if f.current == conn {
+		f.current = nil
+	}
+}
+
+// closeConnections closes all connections after the forwarder stops accepting new ones
+func (f *Forwarder) closeConnections() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.current != nil {
+		f.current.conn.Close()
+		f.current = nil
+	}
+
+	for conn := range f.retired {
+		conn.conn.Close()
+		delete(f.retired, conn)
+	}
+}
+
+func isClosed(conn httpstream.Connection) bool {
+	select {
+	case <-conn.CloseChan():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,311 @@
+package portforward
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+func TestForwarder_Serve(t *testing.T) {
+	t.Run("forward connections to the resolved pod", func(t *testing.T) {
+		pods := &fakePods{podName: "pod-a"}
+		forwarder := pods.forwarder("")
+		addr := fixServedForwarder(t, forwarder, nil)
+
+		require.Equal(t, "pod-a: 8080: ping", fixPing(t, addr))
+		require.Equal(t, "pod-a: 8080: ping", fixPing(t, addr))
+
+		// connect to the new pod after the app rollout
+		pods.set("pod-b", 0)
+		require.Equal(t, "pod-b: 8080: ping", fixPing(t, addr))
+		require.Equal(t, []string{"pod-a", "pod-b"}, pods.dialedPods())
+	})
+
+	t.Run("keep open connection to the previous pod after the pod changes", func(t *testing.T) {
+		pods := &fakePods{podName: "pod-a"}
+		addr := fixServedForwarder(t, pods.forwarder(""), nil)
+
+		conn, err := net.DialTimeout("tcp", addr, time.Second)
+		require.NoError(t, err)
+		reader := bufio.NewReader(conn)
+		require.Equal(t, "pod-a: 8080: ping", fixConnPing(t, conn, reader))
+
+		pods.set("pod-b", 0)
+		require.Equal(t, "pod-b: 8080: ping", fixPing(t, addr))
+
+		// the connection to the previous pod is still open
+		require.Equal(t, "pod-a: 8080: ping", fixConnPing(t, conn, reader))
+		require.False(t, isClosed(pods.connection(0)))
+
+		// and it's closed after the last forwarded connection is done
+		require.NoError(t, conn.Close())
+		require.Eventually(t, func() bool {
+			return isClosed(pods.connection(0))
+		}, time.Second, 10*time.Millisecond)
+		require.False(t, isClosed(pods.connection(1)))
+	})
+
+	t.Run("reconnect while the pod restarts", func(t *testing.T) {
+		pods := &fakePods{podName: "pod-a", err: errors.New("no ready pods"), failures: 2}
+		addr := fixServedForwarder(t, pods.forwarder(""), nil)
+
+		require.Equal(t, "pod-a: 8080: ping", fixPing(t, addr))
+	})
+
+	t.Run("keep serving after connection failure", func(t *testing.T) {
+		pods := &fakePods{err: errors.New("no ready pods"), failures: 3}
+		errs := make(chan error, 1)
+		addr := fixServedForwarder(t, pods.forwarder(""), errs)
+
+		require.Equal(t, "", fixPing(t, addr))
+		require.EqualError(t, <-errs, "no ready pods\nno ready pods\nno ready pods")
+
+		pods.set("pod-a", 0)
+		require.Equal(t, "pod-a: 8080: ping", fixPing(t, addr))
+	})
+
+	t.Run("report remote error", func(t *testing.T) {
+		pods := &fakePods{podName: "pod-a"}
+		errs := make(chan error, 1)
+		addr := fixServedForwarder(t, pods.forwarder("connection refused"), errs)
+
+		require.Equal(t, "pod-a: 8080: ping", fixPing(t, addr))
+		require.EqualError(t, <-errs, "failed to forward connection to port 8080 of the pod-a pod: an error occurred while forwarding: connection refused")
+	})
+
+	t.Run("dial a new connection when the pod connection is closed", func(t *testing.T) {
+		pods := &fakePods{podName: "pod-a"}
+		addr := fixServedForwarder(t, pods.forwarder(""), nil)
+
+		require.Equal(t, "pod-a: 8080: ping", fixPing(t, addr))
+		pods.closeConnections()
+		require.Equal(t, "pod-a: 8080: ping", fixPing(t, addr))
+		require.Equal(t, []string{"pod-a", "pod-a"}, pods.dialedPods())
+	})
+}
+
+// fakePods resolves the current pod and dials fake connections to it
+type fakePods struct {
+	lock    sync.Mutex
+	podName string
+	conns   []*fakeConnection
+	// err is returned by the resolver for the given number of times
+	err      error
+	failures int
+}
+
+func (p *fakePods) forwarder(remoteErrMsg string) *Forwarder {
+	return &Forwarder{
+		remotePort: "8080",
+		resolvePod: func(_ context.Context) (string, error) {
+			p.lock.Lock()
+			defer p.lock.Unlock()
+			if p.failures > 0 {
+				p.failures--
+				return "", p.err
+			}
+			return p.podName, nil
+		},
+		dial: func(podName string) (httpstream.Connection, error) {
+			p.lock.Lock()
+			defer p.lock.Unlock()
+			conn := newFakeConnection(podName, remoteErrMsg)
+			p.conns = append(p.conns, conn)
+			return conn, nil
+		},
+		backoff: []time.Duration{time.Millisecond, time.Millisecond},
+		retired: map[*podConnection]struct{}{},
+	}
+}
+
+func (p *fakePods) set(podName string, failures int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.podName = podName
+	p.failures = failures
+}
+
+func (p *fakePods) dialedPods() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	podNames := []string{}
+	for _, conn := range p.conns {
+		podNames = append(podNames, conn.podName)
+	}
+	return podNames
+}
+
+func (p *fakePods) connection(i int) *fakeConnection {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.conns[i]
+}
+
+func (p *fakePods) closeConnections() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, conn := range p.conns {
+		conn.Close()
+	}
+}
+
+// fixServedForwarder serves the forwarder on a random local port and returns its address
+func fixServedForwarder(t *testing.T, forwarder *Forwarder, errs chan error) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- forwarder.Serve(ctx, listener, func(err error) {
+			errs <- err
+		})
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	return listener.Addr().String()
+}
+
+// fixPing sends the ping line and returns the response line
+func fixPing(t *testing.T, addr string) string {
+	conn, err := net.DialTimeout("tcp", addr, time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("ping\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	response, err := bufio.NewReader(conn).ReadString('\n')
+	if errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) {
+		// the forwarder closed the connection
+		return response
+	}
+	require.NoError(t, err)
+
+	return strings.TrimSuffix(response, "\n")
+}
+
+// fixConnPing sends the ping line over the open connection and returns the response line
+func fixConnPing(t *testing.T, conn net.Conn, reader *bufio.Reader) string {
+	_, err := conn.Write([]byte("ping\n"))
+	require.NoError(t, err)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	response, err := reader.ReadString('\n')
+	require.NoError(t, err)
+
+	return strings.TrimSuffix(response, "\n")
+}
+
+// fakeConnection simulates the pod answering to lines sent to its port
+type fakeConnection struct {
+	podName       string
+	remoteErrMsg  string
+	closeChan     chan bool
+	closeChanOnce sync.Once
+
+	lock sync.Mutex
+	// remote ends of data streams broken when the connection is closed
+	pipes []net.Conn
+}
+
+func newFakeConnection(podName, remoteErrMsg string) *fakeConnection {
+	return &fakeConnection{
+		podName:      podName,
+		remoteErrMsg: remoteErrMsg,
+		closeChan:    make(chan bool),
+	}
+}
+
+func (c *fakeConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	if isClosed(c) {
+		return nil, errors.New("connection closed")
+	}
+
+	if headers.Get(v1.StreamType) == v1.StreamTypeError {
+		return &fakeStream{Reader: strings.NewReader(c.remoteErrMsg), headers: headers}, nil
+	}
+
+	local, remote := net.Pipe()
+	c.lock.Lock()
+	c.pipes = append(c.pipes, remote)
+	c.lock.Unlock()
+	go func() {
+		defer remote.Close()
+		reader := bufio.NewReader(remote)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(remote, "%s: %s: %s", c.podName, headers.Get(v1.PortHeader), line)
+		}
+	}()
+
+	return &fakeStream{Reader: local, Writer: local, Closer: local, headers: headers}, nil
+}
+
+func (c *fakeConnection) Close() error {
+	c.closeChanOnce.Do(func() {
+		close(c.closeChan)
+	})
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, pipe := range c.pipes {
+		pipe.Close()
+	}
+	return nil
+}
+
+func (c *fakeConnection) CloseChan() <-chan bool {
+	return c.closeChan
+}
+
+func (c *fakeConnection) SetIdleTimeout(_ time.Duration) {}
+
+func (c *fakeConnection) RemoveStreams(_ ...httpstream.Stream) {}
+
+type fakeStream struct {
+	io.Reader
+	io.Writer
+	io.Closer
+	headers http.Header
+}
+
+func (s *fakeStream) Close() error {
+	if s.Closer == nil {
+		return nil
+	}
+	return s.Closer.Close()
+}
+
+func (s *fakeStream) Reset() error {
+	return s.Close()
+}
+
+func (s *fakeStream) Headers() http.Header {
+	return s.headers
+}
+
+func (s *fakeStream) Identifier() uint32 {
+	return 0
+}